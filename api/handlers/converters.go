@@ -1,16 +1,30 @@
 package handlers
 
 import (
+	"cmp"
+	"fmt"
+	"slices"
+
 	"github.com/henok321/knobel-manager-service/gen/api"
+	"github.com/henok321/knobel-manager-service/gen/public"
 	"github.com/henok321/knobel-manager-service/pkg/entity"
+	"github.com/henok321/knobel-manager-service/pkg/game"
+	"github.com/henok321/knobel-manager-service/pkg/setup"
+	"github.com/henok321/knobel-manager-service/pkg/standings"
 )
 
 func entityPlayerToAPIPlayer(p entity.Player) api.Player {
-	return api.Player{
+	apiPlayer := api.Player{
 		Id:     p.ID,
 		Name:   p.Name,
 		TeamID: p.TeamID,
 	}
+
+	if p.Substitute {
+		apiPlayer.Substitute = &p.Substitute
+	}
+
+	return apiPlayer
 }
 
 func entityScoreToAPIScore(s entity.Score) api.Score {
@@ -27,14 +41,20 @@ func entityTableToAPITable(tableEntity entity.GameTable) api.Table {
 		Id:          tableEntity.ID,
 		RoundID:     tableEntity.RoundID,
 		TableNumber: tableEntity.TableNumber,
+		Capacity:    tableEntity.Capacity,
+		StageID:     tableEntity.StageID,
 	}
 
 	if len(tableEntity.Players) > 0 {
-		apiPlayers := make([]api.Player, len(tableEntity.Players))
-		for i, player := range tableEntity.Players {
+		seated := tableEntity.SeatedPlayers()
+		apiPlayers := make([]api.Player, len(seated))
+		seatOrder := make([]int, len(seated))
+		for i, player := range seated {
 			apiPlayers[i] = entityPlayerToAPIPlayer(*player)
+			seatOrder[i] = player.ID
 		}
 		apiTable.Players = &apiPlayers
+		apiTable.SeatOrder = &seatOrder
 	}
 
 	if len(tableEntity.Scores) > 0 {
@@ -67,12 +87,24 @@ func entityTeamToAPITeam(teamEntity entity.Team) api.Team {
 }
 
 func entityRoundToAPIRound(roundEntity entity.Round) api.GameRound {
-	return api.GameRound{
+	apiRound := api.GameRound{
 		GameID:      roundEntity.GameID,
 		Id:          roundEntity.ID,
 		RoundNumber: roundEntity.RoundNumber,
+		StageID:     roundEntity.StageID,
 		Status:      api.RoundStatus(roundEntity.Status),
 	}
+
+	if len(roundEntity.Byes) > 0 {
+		byes := make([]int, len(roundEntity.Byes))
+		for i, bye := range roundEntity.Byes {
+			byes[i] = bye.PlayerID
+		}
+
+		apiRound.Byes = &byes
+	}
+
+	return apiRound
 }
 
 func entityGameToAPIGame(gameEntity entity.Game) api.Game {
@@ -83,6 +115,23 @@ func entityGameToAPIGame(gameEntity entity.Game) api.Game {
 		Status:         api.GameStatus(gameEntity.Status),
 		TableSize:      gameEntity.TableSize,
 		TeamSize:       gameEntity.TeamSize,
+		PairingMode:    api.PairingMode(gameEntity.PairingMode),
+		Mode:           api.GameMode(gameEntity.Mode),
+		Ranking:        entityGameToAPIRanking(gameEntity),
+		Seating: api.Seating{
+			ShortTables: gameEntity.ShortTables,
+			Byes:        gameEntity.Byes,
+			ByeScore:    gameEntity.ByeScore,
+		},
+	}
+
+	if len(gameEntity.TableLayout) > 0 {
+		tableLayout := api.TableLayout(gameEntity.TableLayout)
+		apiGame.TableLayout = &tableLayout
+	}
+
+	if gameEntity.DrawSeed != nil && gameEntity.DrawAlgorithm != nil {
+		apiGame.Draw = &api.Draw{Seed: *gameEntity.DrawSeed, Algorithm: *gameEntity.DrawAlgorithm}
 	}
 
 	if len(gameEntity.Owners) > 0 {
@@ -104,6 +153,14 @@ func entityGameToAPIGame(gameEntity entity.Game) api.Game {
 		apiGame.Teams = &teamsSlice
 	}
 
+	if len(gameEntity.Players) > 0 {
+		players := make([]api.Player, len(gameEntity.Players))
+		for i, player := range gameEntity.Players {
+			players[i] = entityPlayerToAPIPlayer(*player)
+		}
+		apiGame.Players = &players
+	}
+
 	if len(gameEntity.Rounds) > 0 {
 		rounds := make([]api.GameRound, len(gameEntity.Rounds))
 		for i, round := range gameEntity.Rounds {
@@ -114,3 +171,424 @@ func entityGameToAPIGame(gameEntity entity.Game) api.Game {
 
 	return apiGame
 }
+
+func entityScoreHistoryToAPI(entry entity.ScoreHistory) api.ScoreHistoryEntry {
+	return api.ScoreHistoryEntry{
+		Id:         entry.ID,
+		PlayerID:   entry.PlayerID,
+		PlayerName: entry.PlayerName,
+		OldScore:   entry.OldScore,
+		NewScore:   entry.NewScore,
+		OwnerSub:   entry.OwnerSub,
+		Reason:     entry.Reason,
+		CreatedAt:  entry.CreatedAt,
+	}
+}
+
+func entitySubstitutionToAPI(substitution entity.Substitution) api.Substitution {
+	apiSubstitution := api.Substitution{
+		Id:           substitution.ID,
+		PlayerID:     substitution.PlayerID,
+		SubstituteID: substitution.SubstituteID,
+		CreatedAt:    substitution.CreatedAt,
+	}
+
+	if substitution.Round != nil {
+		apiSubstitution.RoundNumber = substitution.Round.RoundNumber
+	}
+
+	if substitution.Table != nil {
+		apiSubstitution.TableNumber = substitution.Table.TableNumber
+	}
+
+	return apiSubstitution
+}
+
+func entityDrawVerificationToAPI(verification game.DrawVerification) api.DrawVerification {
+	mismatches := make([]api.DrawMismatch, len(verification.Mismatches))
+	for i, mismatch := range verification.Mismatches {
+		mismatches[i] = api.DrawMismatch{RoundNumber: mismatch.RoundNumber, TableNumber: mismatch.TableNumber}
+	}
+
+	return api.DrawVerification{
+		Seed:       verification.Seed,
+		Algorithm:  verification.Algorithm,
+		Matches:    verification.Matches,
+		Mismatches: mismatches,
+	}
+}
+
+// drawPreviewToAPI converts a draw preview. It fails if a table of the preview seats a player that
+// could not be resolved.
+func drawPreviewToAPI(preview game.DrawPreview) (api.SetupPreview, error) {
+	rounds := make([]api.PreviewRound, len(preview.Rounds))
+
+	for i, round := range preview.Rounds {
+		rounds[i] = api.PreviewRound{RoundNumber: round.RoundNumber, Tables: make([]api.PreviewTable, len(round.Tables))}
+
+		for j, table := range round.Tables {
+			players := make([]api.Player, len(table.Players))
+			for k, player := range table.Players {
+				if player == nil {
+					return api.SetupPreview{}, fmt.Errorf("unknown player at table %d of round %d", table.TableNumber, round.RoundNumber)
+				}
+
+				players[k] = entityPlayerToAPIPlayer(*player)
+			}
+
+			rounds[i].Tables[j] = api.PreviewTable{TableNumber: table.TableNumber, Capacity: table.Capacity, Players: players}
+		}
+
+		if len(round.Byes) > 0 {
+			byes := make([]int, len(round.Byes))
+			for j, bye := range round.Byes {
+				byes[j] = bye.PlayerID
+			}
+
+			rounds[i].Byes = &byes
+		}
+	}
+
+	return api.SetupPreview{
+		Seed:      preview.Seed,
+		Algorithm: preview.Algorithm,
+		Token:     preview.Token,
+		Quality: api.DrawQuality{
+			PlayerRepeats:       preview.Quality.PlayerRepeats,
+			MaxPlayerEncounters: preview.Quality.MaxPlayerEncounters,
+			TeamRepeats:         preview.Quality.TeamRepeats,
+			MaxTeamEncounters:   preview.Quality.MaxTeamEncounters,
+			Cost:                preview.Quality.Cost,
+		},
+		Rounds: rounds,
+	}, nil
+}
+
+func setupReportToAPI(report setup.Report) api.SetupReport {
+	tableSpread := make([]api.PlayerTableSpread, len(report.TableSpread))
+	for i, spread := range report.TableSpread {
+		tableSpread[i] = api.PlayerTableSpread{PlayerID: spread.PlayerID, Tables: spread.Tables, DistinctTables: spread.DistinctTables}
+	}
+
+	violations := make([]api.Violation, len(report.Violations))
+	for i, violation := range report.Violations {
+		violations[i] = api.Violation{
+			Type:        api.ViolationType(violation.Type),
+			RoundNumber: violation.RoundNumber,
+			TableNumber: violation.TableNumber,
+			PlayerIDs:   violation.PlayerIDs,
+		}
+	}
+
+	return api.SetupReport{
+		PlayerEncounters:    api.EncounterMatrix{Ids: report.PlayerEncounters.IDs, Counts: report.PlayerEncounters.Counts},
+		TeamEncounters:      api.EncounterMatrix{Ids: report.TeamEncounters.IDs, Counts: report.TeamEncounters.Counts},
+		MaxPlayerEncounters: report.MaxPlayerEncounters,
+		MaxTeamEncounters:   report.MaxTeamEncounters,
+		TableSpread:         tableSpread,
+		Violations:          violations,
+	}
+}
+
+func entityGameToAPIRanking(gameEntity entity.Game) api.Ranking {
+	ranking := api.Ranking{
+		ScoreDirection: api.ScoreDirection(gameEntity.ScoreDirection),
+		TieBreakers:    make([]api.TieBreaker, len(gameEntity.TieBreakers)),
+	}
+
+	for i, tieBreaker := range gameEntity.TieBreakers {
+		ranking.TieBreakers[i] = api.TieBreaker(tieBreaker)
+	}
+
+	if gameEntity.CoinFlipSeed != 0 {
+		seed := gameEntity.CoinFlipSeed
+		ranking.CoinFlipSeed = &seed
+	}
+
+	return ranking
+}
+
+func roundSubtotalsToAPI(subtotals []standings.RoundSubtotal) []api.RoundSubtotal {
+	apiSubtotals := make([]api.RoundSubtotal, len(subtotals))
+	for i, subtotal := range subtotals {
+		apiSubtotals[i] = api.RoundSubtotal{RoundNumber: subtotal.RoundNumber, Score: subtotal.Score}
+	}
+
+	return apiSubtotals
+}
+
+func standingsToAPIStandings(s standings.Standings) api.Standings {
+	apiStandings := api.Standings{
+		GameID:     s.GameID,
+		AfterRound: s.AfterRound,
+		Complete:   s.Complete,
+		Teams:      make([]api.TeamStanding, len(s.Teams)),
+		Players:    make([]api.PlayerStanding, len(s.Players)),
+	}
+
+	for i, team := range s.Teams {
+		apiStandings.Teams[i] = api.TeamStanding{
+			Rank:        team.Rank,
+			TeamID:      team.TeamID,
+			Name:        team.Name,
+			TotalScore:  team.TotalScore,
+			GapToLeader: team.GapToLeader,
+			Rounds:      roundSubtotalsToAPI(team.Rounds),
+			Complete:    team.Complete,
+			Byes:        team.Byes,
+			ShortTables: team.ShortTables,
+		}
+
+		if team.PreviousRank > 0 {
+			apiStandings.Teams[i].PreviousRank = &team.PreviousRank
+			apiStandings.Teams[i].RankChange = &team.RankChange
+		}
+	}
+
+	for i, player := range s.Players {
+		apiStandings.Players[i] = api.PlayerStanding{
+			Rank:           player.Rank,
+			PlayerID:       player.PlayerID,
+			Name:           player.Name,
+			TotalScore:     player.TotalScore,
+			GapToLeader:    player.GapToLeader,
+			Rounds:         roundSubtotalsToAPI(player.Rounds),
+			Complete:       player.Complete,
+			Byes:           player.Byes,
+			ShortTables:    player.ShortTables,
+			SubstitutedOut: player.SubstitutedOut,
+			SubstitutedIn:  player.SubstitutedIn,
+		}
+
+		if player.TeamID > 0 {
+			apiStandings.Players[i].TeamID = &player.TeamID
+		}
+
+		if player.PreviousRank > 0 {
+			apiStandings.Players[i].PreviousRank = &player.PreviousRank
+			apiStandings.Players[i].RankChange = &player.RankChange
+		}
+	}
+
+	return apiStandings
+}
+
+func entityFinalToAPIFinal(finalEntity entity.Final) api.Final {
+	tables := make([]api.FinalTable, len(finalEntity.Tables))
+	for i, table := range finalEntity.Tables {
+		tables[i] = entityFinalTableToAPI(*table)
+	}
+
+	return api.Final{
+		Id:        finalEntity.ID,
+		GameID:    finalEntity.GameID,
+		Finalists: finalEntity.Finalists,
+		Status:    api.FinalStatus(finalEntity.Status),
+		Tables:    tables,
+	}
+}
+
+func entityFinalTableToAPI(tableEntity entity.FinalTable) api.FinalTable {
+	players := make([]api.FinalPlayer, len(tableEntity.Players))
+	for i, player := range tableEntity.Players {
+		players[i] = api.FinalPlayer{PlayerID: player.PlayerID, Seed: player.Seed, Score: player.Score}
+	}
+
+	return api.FinalTable{
+		Id:          tableEntity.ID,
+		TableNumber: tableEntity.TableNumber,
+		Players:     players,
+	}
+}
+
+func finalRankingToAPI(ranking standings.FinalRanking) api.FinalRanking {
+	apiRanking := api.FinalRanking{
+		GameID:   ranking.GameID,
+		Complete: ranking.Complete,
+		Players:  make([]api.FinalStanding, len(ranking.Players)),
+	}
+
+	for i, player := range ranking.Players {
+		apiRanking.Players[i] = api.FinalStanding{
+			Rank:             player.Rank,
+			PlayerID:         player.PlayerID,
+			Name:             player.Name,
+			Finalist:         player.Finalist,
+			FinalScore:       player.FinalScore,
+			PreliminaryRank:  player.PreliminaryRank,
+			PreliminaryScore: player.PreliminaryScore,
+		}
+
+		if player.TeamID > 0 {
+			apiRanking.Players[i].TeamID = &player.TeamID
+		}
+
+		if player.Seed > 0 {
+			apiRanking.Players[i].Seed = &player.Seed
+		}
+	}
+
+	return apiRanking
+}
+
+func entityStageToAPIStage(stageEntity entity.Stage) api.Stage {
+	apiStage := api.Stage{
+		Id:             stageEntity.ID,
+		Position:       stageEntity.Position,
+		Name:           stageEntity.Name,
+		PairingMode:    api.PairingMode(stageEntity.PairingMode),
+		NumberOfRounds: stageEntity.NumberOfRounds,
+		Groups:         stageEntity.Groups,
+		Qualification:  api.QualificationRule(stageEntity.Qualification),
+		Qualifiers:     stageEntity.Qualifiers,
+		CarryOver:      api.CarryOver(stageEntity.CarryOver),
+		Status:         api.StageStatus(stageEntity.Status),
+	}
+
+	if len(stageEntity.Players) > 0 {
+		players := make([]api.StagePlayer, len(stageEntity.Players))
+		for i, player := range stageEntity.Players {
+			players[i] = api.StagePlayer{PlayerID: player.PlayerID, Group: player.GroupNumber, CarriedScore: player.CarriedScore}
+		}
+
+		apiStage.Players = &players
+	}
+
+	return apiStage
+}
+
+func entityShareToAPIShare(shareEntity entity.GameShare) api.Share {
+	return api.Share{
+		Token:           shareEntity.Token,
+		AbbreviateNames: shareEntity.AbbreviateNames,
+		CreatedAt:       shareEntity.CreatedAt,
+	}
+}
+
+func entityGameToPublicGame(gameEntity entity.Game) public.PublicGame {
+	return public.PublicGame{
+		Name:           gameEntity.Name,
+		Status:         public.GameStatus(gameEntity.Status),
+		NumberOfRounds: gameEntity.NumberOfRounds,
+	}
+}
+
+func standingsToPublicStandings(s standings.Standings) public.PublicStandings {
+	publicStandings := public.PublicStandings{
+		AfterRound: s.AfterRound,
+		Complete:   s.Complete,
+		Teams:      make([]public.PublicTeamStanding, len(s.Teams)),
+		Players:    make([]public.PublicPlayerStanding, len(s.Players)),
+	}
+
+	teamNames := make(map[int]string, len(s.Teams))
+
+	for i, team := range s.Teams {
+		teamNames[team.TeamID] = team.Name
+		publicStandings.Teams[i] = public.PublicTeamStanding{
+			Rank:       team.Rank,
+			Name:       team.Name,
+			TotalScore: team.TotalScore,
+			Rounds:     roundSubtotalsToPublic(team.Rounds),
+		}
+	}
+
+	for i, player := range s.Players {
+		publicStandings.Players[i] = public.PublicPlayerStanding{
+			Rank:       player.Rank,
+			Name:       player.Name,
+			TotalScore: player.TotalScore,
+			Rounds:     roundSubtotalsToPublic(player.Rounds),
+		}
+
+		if teamName, ok := teamNames[player.TeamID]; ok {
+			publicStandings.Players[i].Team = &teamName
+		}
+	}
+
+	return publicStandings
+}
+
+func roundSubtotalsToPublic(subtotals []standings.RoundSubtotal) []public.RoundSubtotal {
+	publicSubtotals := make([]public.RoundSubtotal, len(subtotals))
+	for i, subtotal := range subtotals {
+		publicSubtotals[i] = public.RoundSubtotal{RoundNumber: subtotal.RoundNumber, Score: subtotal.Score}
+	}
+
+	return publicSubtotals
+}
+
+// entityRoundsToPublicRounds lists the rounds of a game with their tables in seat order. Players are
+// referred to by name only; team names are left out in individual games.
+func entityRoundsToPublicRounds(gameEntity entity.Game) []public.PublicRound {
+	teamNames := make(map[int]string)
+	playerNames := make(map[int]string)
+
+	for _, team := range gameEntity.Teams {
+		for _, player := range team.Players {
+			teamNames[player.ID] = team.Name
+		}
+	}
+
+	for _, player := range gameEntity.AllPlayers() {
+		playerNames[player.ID] = player.Name
+	}
+
+	rounds := slices.Clone(gameEntity.Rounds)
+	slices.SortFunc(rounds, func(a, b *entity.Round) int {
+		return cmp.Compare(a.RoundNumber, b.RoundNumber)
+	})
+
+	publicRounds := make([]public.PublicRound, len(rounds))
+
+	for i, round := range rounds {
+		tables := slices.Clone(round.Tables)
+		slices.SortFunc(tables, func(a, b *entity.GameTable) int {
+			return cmp.Compare(a.TableNumber, b.TableNumber)
+		})
+
+		publicRound := public.PublicRound{
+			RoundNumber: round.RoundNumber,
+			Status:      public.RoundStatus(round.Status),
+			Tables:      make([]public.PublicTable, len(tables)),
+			Byes:        make([]string, 0, len(round.Byes)),
+		}
+
+		for j, table := range tables {
+			scores := make(map[int]int, len(table.Scores))
+			for _, score := range table.Scores {
+				scores[score.PlayerID] = score.Score
+			}
+
+			seatedPlayers := table.SeatedPlayers()
+			publicTable := public.PublicTable{
+				TableNumber: table.TableNumber,
+				Players:     make([]public.PublicSeat, len(seatedPlayers)),
+			}
+
+			for seat, player := range seatedPlayers {
+				publicSeat := public.PublicSeat{Seat: seat + 1, Name: player.Name}
+
+				if teamName, ok := teamNames[player.ID]; ok {
+					publicSeat.Team = &teamName
+				}
+
+				if score, ok := scores[player.ID]; ok {
+					publicSeat.Score = &score
+				}
+
+				publicTable.Players[seat] = publicSeat
+			}
+
+			publicRound.Tables[j] = publicTable
+		}
+
+		for _, bye := range round.Byes {
+			publicRound.Byes = append(publicRound.Byes, playerNames[bye.PlayerID])
+		}
+
+		publicRounds[i] = publicRound
+	}
+
+	return publicRounds
+}
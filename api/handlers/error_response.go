@@ -45,10 +45,94 @@ func respondError(w http.ResponseWriter, err error) {
 		JSONError(w, "Player not found", http.StatusNotFound)
 	case errors.Is(err, apperror.ErrRoundOrTableNotFound):
 		JSONError(w, "Round or table not found", http.StatusNotFound)
+	case errors.Is(err, apperror.ErrRoundNotFound):
+		JSONError(w, "Round not found", http.StatusNotFound)
+	case errors.Is(err, apperror.ErrRoundNotInProgress):
+		JSONError(w, "Round is not in progress", http.StatusConflict)
+	case errors.Is(err, apperror.ErrRoundInProgress):
+		JSONError(w, "Another round is already in progress", http.StatusConflict)
+	case errors.Is(err, apperror.ErrGameNotInProgress):
+		JSONError(w, "Game is not in progress", http.StatusConflict)
+	case errors.Is(err, apperror.ErrRoundNotInSetup):
+		JSONError(w, "Round has already been started", http.StatusConflict)
+	case errors.Is(err, apperror.ErrRoundIncomplete):
+		JSONError(w, "Round has missing scores", http.StatusConflict)
+	case errors.Is(err, apperror.ErrReasonRequired):
+		JSONError(w, "Reason required for completed round", http.StatusBadRequest)
+	case errors.Is(err, apperror.ErrNotSwissPairing):
+		JSONError(w, "Game does not use swiss pairing", http.StatusConflict)
+	case errors.Is(err, apperror.ErrRoundNotCompleted):
+		JSONError(w, "Previous round is not completed", http.StatusConflict)
+	case errors.Is(err, apperror.ErrRoundCompleted):
+		JSONError(w, "Round is already completed", http.StatusConflict)
+	case errors.Is(err, apperror.ErrRoundScored):
+		JSONError(w, "Round already has scores", http.StatusConflict)
+	case errors.Is(err, apperror.ErrSameTable):
+		JSONError(w, "Players already sit at the same table", http.StatusConflict)
+	case errors.Is(err, apperror.ErrTableFull):
+		JSONError(w, "Table has no free seat", http.StatusConflict)
+	case errors.Is(err, apperror.ErrTableTooSmall):
+		JSONError(w, "Table would have fewer than two players", http.StatusConflict)
+	case errors.Is(err, apperror.ErrTableScored):
+		JSONError(w, "Table already has scores", http.StatusConflict)
+	case errors.Is(err, apperror.ErrTeammatesSeated):
+		JSONError(w, "Teammates cannot share a table", http.StatusConflict)
+	case errors.Is(err, apperror.ErrInvalidSeatOrder):
+		JSONError(w, "Seat order must list every player of the table once", http.StatusBadRequest)
+	case errors.Is(err, apperror.ErrPlayerNotSeated):
+		JSONError(w, "Player is not seated in the round", http.StatusConflict)
+	case errors.Is(err, apperror.ErrPlayerAlreadyScored):
+		JSONError(w, "Player already has a score in the round", http.StatusConflict)
+	case errors.Is(err, apperror.ErrInvalidSubstitute):
+		JSONError(w, "Substitute is not available", http.StatusConflict)
+	case errors.Is(err, apperror.ErrAllRoundsDrawn):
+		JSONError(w, "All rounds have been drawn", http.StatusConflict)
+	case errors.Is(err, apperror.ErrPreviewOutdated):
+		JSONError(w, "Draw preview is outdated", http.StatusConflict)
+	case errors.Is(err, apperror.ErrDrawNotReproducible):
+		JSONError(w, "Draw cannot be reproduced", http.StatusConflict)
+	case errors.Is(err, apperror.ErrFinalOrTableNotFound):
+		JSONError(w, "Final or table not found", http.StatusNotFound)
+	case errors.Is(err, apperror.ErrFinalExists):
+		JSONError(w, "Game already has a final", http.StatusConflict)
+	case errors.Is(err, apperror.ErrFinalCompleted):
+		JSONError(w, "Final is already completed", http.StatusConflict)
+	case errors.Is(err, apperror.ErrFinalIncomplete):
+		JSONError(w, "Final has missing scores", http.StatusConflict)
+	case errors.Is(err, apperror.ErrPreliminariesOpen):
+		JSONError(w, "Preliminary rounds are not completed", http.StatusConflict)
+	case errors.Is(err, apperror.ErrInvalidFinalists):
+		JSONError(w, "Invalid number of finalists", http.StatusBadRequest)
+	case errors.Is(err, apperror.ErrStageNotFound):
+		JSONError(w, "Stage not found", http.StatusNotFound)
+	case errors.Is(err, apperror.ErrInvalidStages):
+		JSONError(w, "Invalid stage configuration", http.StatusBadRequest)
+	case errors.Is(err, apperror.ErrStagesLocked):
+		JSONError(w, "Stages cannot be changed after rounds have been drawn", http.StatusConflict)
+	case errors.Is(err, apperror.ErrSeatingLocked):
+		JSONError(w, "Seating cannot be changed after rounds have been drawn", http.StatusConflict)
+	case errors.Is(err, apperror.ErrStagedGame):
+		JSONError(w, "Game is played in stages", http.StatusConflict)
+	case errors.Is(err, apperror.ErrStageIncomplete):
+		JSONError(w, "Current stage is not completed", http.StatusConflict)
+	case errors.Is(err, apperror.ErrNoNextStage):
+		JSONError(w, "No further stage", http.StatusConflict)
+	case errors.Is(err, apperror.ErrInvalidArchive):
+		JSONError(w, "Invalid archive", http.StatusBadRequest)
+	case errors.Is(err, apperror.ErrArchiveVersion):
+		JSONError(w, "Unsupported archive version", http.StatusBadRequest)
+	case errors.Is(err, apperror.ErrInvalidImport):
+		JSONError(w, "Invalid lines", http.StatusUnprocessableEntity)
+	case errors.Is(err, apperror.ErrEmptyImport):
+		JSONError(w, "No team to import", http.StatusBadRequest)
 	case errors.Is(err, apperror.ErrInvalidScore):
 		JSONError(w, "Invalid score", http.StatusBadRequest)
 	case errors.Is(err, apperror.ErrTeamSizeNotAllowed):
 		JSONError(w, "Invalid team size", http.StatusBadRequest)
+	case errors.Is(err, apperror.ErrGameModeMismatch):
+		JSONError(w, "Not supported in the game mode", http.StatusConflict)
+	case errors.Is(err, apperror.ErrSwissSubstitution):
+		JSONError(w, "Substitutions for the remaining rounds are not supported with swiss pairing", http.StatusConflict)
 	case errors.Is(err, apperror.ErrInvalidGameSetup):
 		JSONError(w, "Invalid game setup", http.StatusConflict)
 	case errors.Is(err, apperror.ErrGameIncomplete):
@@ -57,6 +141,8 @@ func respondError(w http.ResponseWriter, err error) {
 		JSONError(w, "Already an owner", http.StatusConflict)
 	case errors.Is(err, apperror.ErrLastOwner):
 		JSONError(w, "Cannot remove the last owner", http.StatusConflict)
+	case errors.Is(err, apperror.ErrShareNotFound):
+		JSONError(w, "Share not found", http.StatusNotFound)
 	case errors.Is(err, apperror.ErrUserNotFound):
 		JSONError(w, "No user found for the given email", http.StatusUnprocessableEntity)
 	default:
@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/henok321/knobel-manager-service/gen/api"
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+	"github.com/henok321/knobel-manager-service/pkg/final"
+	"github.com/henok321/knobel-manager-service/pkg/standings"
+)
+
+type FinalsHandler struct {
+	finalsService    *final.FinalsService
+	standingsService *standings.StandingsService
+}
+
+func NewFinalsHandler(finalsService *final.FinalsService, standingsService *standings.StandingsService) *FinalsHandler {
+	return &FinalsHandler{finalsService: finalsService, standingsService: standingsService}
+}
+
+func (h *FinalsHandler) GetFinal(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	gameFinal, err := h.finalsService.Final(ctx, gameID, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writeFinal(ctx, writer, gameFinal, http.StatusOK)
+}
+
+func (h *FinalsHandler) CreateFinal(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	finalRequest := api.FinalCreateRequest{}
+
+	if err := json.NewDecoder(request.Body).Decode(&finalRequest); err != nil {
+		JSONError(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	createdFinal, err := h.finalsService.CreateFinal(ctx, gameID, sub, finalRequest.Finalists)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writer.Header().Set("Location", fmt.Sprintf("/games/%d/finals", gameID))
+	writeFinal(ctx, writer, createdFinal, http.StatusCreated)
+}
+
+func (h *FinalsHandler) CloseFinal(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	closedFinal, err := h.finalsService.CloseFinal(ctx, gameID, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writeFinal(ctx, writer, closedFinal, http.StatusOK)
+}
+
+func (h *FinalsHandler) GetFinalRanking(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	ranking, err := h.standingsService.FinalRanking(ctx, gameID, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	response := api.FinalRankingResponse{
+		Ranking: finalRankingToAPI(ranking),
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
+
+func (h *FinalsHandler) UpdateFinalScores(writer http.ResponseWriter, request *http.Request, gameID, tableNumber int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	scoresRequest := api.ScoresRequest{}
+
+	if err := json.NewDecoder(request.Body).Decode(&scoresRequest); err != nil {
+		JSONError(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if len(scoresRequest.Scores) == 0 {
+		JSONError(writer, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	updatedTable, err := h.finalsService.UpdateScores(ctx, gameID, tableNumber, sub, scoresRequest)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	response := api.FinalTableResponse{
+		Table: entityFinalTableToAPI(updatedTable),
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
+
+func writeFinal(ctx context.Context, writer http.ResponseWriter, finalEntity entity.Final, status int) {
+	response := api.FinalResponse{
+		Final: entityFinalToAPIFinal(finalEntity),
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
@@ -3,7 +3,9 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 
@@ -11,6 +13,7 @@ import (
 
 	"github.com/henok321/knobel-manager-service/api/middleware"
 	"github.com/henok321/knobel-manager-service/gen/api"
+	"github.com/henok321/knobel-manager-service/pkg/archive"
 	"github.com/henok321/knobel-manager-service/pkg/entity"
 	"github.com/henok321/knobel-manager-service/pkg/game"
 )
@@ -147,6 +150,21 @@ func (h *GamesHandler) CreateGame(writer http.ResponseWriter, request *http.Requ
 		return
 	}
 
+	if gameCreateRequest.Mode != nil && !gameCreateRequest.Mode.Valid() {
+		JSONError(writer, "Invalid game mode", http.StatusBadRequest)
+		return
+	}
+
+	if gameCreateRequest.PairingMode != nil && !gameCreateRequest.PairingMode.Valid() {
+		JSONError(writer, "Invalid pairing mode", http.StatusBadRequest)
+		return
+	}
+
+	if gameCreateRequest.TableLayout != nil && !validTableLayout(*gameCreateRequest.TableLayout) {
+		JSONError(writer, "Invalid table layout", http.StatusBadRequest)
+		return
+	}
+
 	createdGame, err := h.gamesService.CreateGame(ctx, sub, &gameCreateRequest)
 	if err != nil {
 		respondError(writer, err)
@@ -189,6 +207,21 @@ func (h *GamesHandler) UpdateGame(writer http.ResponseWriter, request *http.Requ
 		return
 	}
 
+	if gameUpdateRequest.PairingMode != nil && !gameUpdateRequest.PairingMode.Valid() {
+		JSONError(writer, "Invalid pairing mode", http.StatusBadRequest)
+		return
+	}
+
+	if gameUpdateRequest.Ranking != nil && !validRanking(*gameUpdateRequest.Ranking) {
+		JSONError(writer, "Invalid ranking", http.StatusBadRequest)
+		return
+	}
+
+	if gameUpdateRequest.TableLayout != nil && !validTableLayout(*gameUpdateRequest.TableLayout) {
+		JSONError(writer, "Invalid table layout", http.StatusBadRequest)
+		return
+	}
+
 	updatedGame, err := h.gamesService.UpdateGame(ctx, gameID, sub, gameUpdateRequest)
 	if err != nil {
 		respondError(writer, err)
@@ -209,6 +242,112 @@ func (h *GamesHandler) UpdateGame(writer http.ResponseWriter, request *http.Requ
 	}
 }
 
+func (h *GamesHandler) CloneGame(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	cloneRequest := api.GameCloneRequest{}
+
+	if err := json.NewDecoder(request.Body).Decode(&cloneRequest); err != nil && !errors.Is(err, io.EOF) {
+		JSONError(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if cloneRequest.Name != nil && *cloneRequest.Name == "" {
+		JSONError(writer, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	clonedGame, err := h.gamesService.CloneGame(ctx, gameID, sub, cloneRequest)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.Header().Set("Location", fmt.Sprintf("/games/%d", clonedGame.ID))
+	writer.WriteHeader(http.StatusCreated)
+
+	apiGame := entityGameToAPIGame(clonedGame)
+	h.enrichOwnerEmails(ctx, &apiGame)
+	response := api.GameResponse{
+		Game: apiGame,
+	}
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
+
+func (h *GamesHandler) ExportGame(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	gameByID, err := h.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"game-%d.json\"", gameByID.ID))
+	writer.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(writer).Encode(archive.FromGame(gameByID)); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
+
+func (h *GamesHandler) ImportGame(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	gameArchive := api.GameArchive{}
+
+	if err := json.NewDecoder(request.Body).Decode(&gameArchive); err != nil {
+		JSONError(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	archived, err := archive.ToGame(gameArchive)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	importedGame, err := h.gamesService.ImportGame(ctx, sub, archived)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.Header().Set("Location", fmt.Sprintf("/games/%d", importedGame.ID))
+	writer.WriteHeader(http.StatusCreated)
+
+	apiGame := entityGameToAPIGame(importedGame)
+	h.enrichOwnerEmails(ctx, &apiGame)
+	response := api.GameResponse{
+		Game: apiGame,
+	}
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
+
 func (h *GamesHandler) AddOwner(writer http.ResponseWriter, request *http.Request, gameID int) {
 	ctx := request.Context()
 
@@ -301,21 +440,205 @@ func (h *GamesHandler) SetupGame(writer http.ResponseWriter, request *http.Reque
 		return
 	}
 
+	if !readyForSetup(writer, gameToAssign) {
+		return
+	}
+
+	setupRequest := api.SetupRequest{}
+
+	if err := json.NewDecoder(request.Body).Decode(&setupRequest); err != nil && !errors.Is(err, io.EOF) {
+		JSONError(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = h.gamesService.AssignTables(ctx, gameToAssign, setupRequest.Seed)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writer.WriteHeader(http.StatusNoContent)
+}
+
+func (h *GamesHandler) PreviewSetup(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	gameToAssign, err := h.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	if !readyForSetup(writer, gameToAssign) {
+		return
+	}
+
+	setupRequest := api.SetupRequest{}
+
+	if err := json.NewDecoder(request.Body).Decode(&setupRequest); err != nil && !errors.Is(err, io.EOF) {
+		JSONError(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	preview, err := h.gamesService.PreviewDraw(gameToAssign, setupRequest.Seed)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	previewResponse, err := drawPreviewToAPI(preview)
+	if err != nil {
+		slog.ErrorContext(ctx, "Could not convert draw preview", "error", err)
+		respondError(writer, err)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(writer).Encode(api.SetupPreviewResponse{Preview: previewResponse}); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
+
+func (h *GamesHandler) AcceptSetup(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	gameToAssign, err := h.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	if !readyForSetup(writer, gameToAssign) {
+		return
+	}
+
+	acceptRequest := api.SetupAcceptRequest{}
+
+	if err := json.NewDecoder(request.Body).Decode(&acceptRequest); err != nil || acceptRequest.Token == "" {
+		JSONError(writer, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.gamesService.AcceptDraw(ctx, gameToAssign, acceptRequest.Seed, acceptRequest.Token); err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writer.WriteHeader(http.StatusNoContent)
+}
+
+// readyForSetup responds with an error unless the tables of the game can be drawn.
+func readyForSetup(writer http.ResponseWriter, gameToAssign entity.Game) bool {
 	if gameToAssign.Status != entity.StatusSetup {
 		JSONError(writer, "Game is not in setup state", http.StatusBadRequest)
-		return
+		return false
+	}
+
+	if gameToAssign.Mode == entity.GameModeIndividual {
+		players := 0
+
+		for _, player := range gameToAssign.AllPlayers() {
+			if !player.Substitute {
+				players++
+			}
+		}
+
+		if players < gameToAssign.TableSize {
+			JSONError(writer, "Not enough players to assign tables", http.StatusConflict)
+			return false
+		}
+
+		return true
 	}
 
 	if len(gameToAssign.Teams) < gameToAssign.TableSize {
 		JSONError(writer, "Not enough teams to assign tables", http.StatusConflict)
+		return false
+	}
+
+	return true
+}
+
+func (h *GamesHandler) GetSetupReport(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
 		return
 	}
 
-	err = h.gamesService.AssignTables(ctx, gameToAssign)
+	report, err := h.gamesService.SetupReport(ctx, gameID, sub)
 	if err != nil {
 		respondError(writer, err)
 		return
 	}
 
-	writer.WriteHeader(http.StatusNoContent)
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(writer).Encode(api.SetupReportResponse{Report: setupReportToAPI(report)}); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
+
+func (h *GamesHandler) VerifySetup(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	verification, err := h.gamesService.VerifyDraw(ctx, gameID, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(writer).Encode(api.DrawVerificationResponse{Verification: entityDrawVerificationToAPI(verification)}); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
+
+func validRanking(ranking api.Ranking) bool {
+	if !ranking.ScoreDirection.Valid() {
+		return false
+	}
+
+	seen := map[api.TieBreaker]struct{}{}
+
+	for _, tieBreaker := range ranking.TieBreakers {
+		if _, duplicate := seen[tieBreaker]; duplicate || !tieBreaker.Valid() {
+			return false
+		}
+
+		seen[tieBreaker] = struct{}{}
+	}
+
+	return true
+}
+
+func validTableLayout(tableLayout api.TableLayout) bool {
+	for _, seats := range tableLayout {
+		if seats < 2 {
+			return false
+		}
+	}
+
+	return true
 }
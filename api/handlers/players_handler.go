@@ -110,3 +110,94 @@ func (h *PlayersHandler) DeletePlayer(writer http.ResponseWriter, request *http.
 
 	writer.WriteHeader(http.StatusNoContent)
 }
+
+func (h *PlayersHandler) CreateGamePlayer(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	playersRequest := api.PlayersRequest{}
+
+	if err := json.NewDecoder(request.Body).Decode(&playersRequest); err != nil {
+		JSONError(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if playersRequest.Name == "" {
+		JSONError(writer, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	createPlayer, err := h.playersService.CreateGamePlayer(ctx, playersRequest, gameID, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writer.Header().Set("Location", fmt.Sprintf("/games/%d/players/%d", gameID, createPlayer.ID))
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusCreated)
+
+	response := api.PlayersResponse{
+		Player: entityPlayerToAPIPlayer(createPlayer),
+	}
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
+
+func (h *PlayersHandler) UpdateGamePlayer(writer http.ResponseWriter, request *http.Request, gameID, playerID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	playersRequest := api.PlayersRequest{}
+
+	if err := json.NewDecoder(request.Body).Decode(&playersRequest); err != nil {
+		JSONError(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if playersRequest.Name == "" {
+		JSONError(writer, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	updatePlayer, err := h.playersService.UpdateGamePlayer(ctx, gameID, playerID, playersRequest, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusOK)
+
+	response := api.PlayersResponse{
+		Player: entityPlayerToAPIPlayer(updatePlayer),
+	}
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
+
+func (h *PlayersHandler) DeleteGamePlayer(writer http.ResponseWriter, request *http.Request, gameID, playerID int) {
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	if err := h.playersService.DeleteGamePlayer(request.Context(), gameID, playerID, sub); err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writer.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+	"github.com/henok321/knobel-manager-service/pkg/printout"
+)
+
+type PrintoutsHandler struct {
+	printoutsService *printout.PrintoutsService
+}
+
+func NewPrintoutsHandler(printoutsService *printout.PrintoutsService) *PrintoutsHandler {
+	return &PrintoutsHandler{printoutsService: printoutsService}
+}
+
+func (h *PrintoutsHandler) GetSeatingPlan(writer http.ResponseWriter, request *http.Request, gameID, roundNumber int) {
+	h.writePDF(writer, request, gameID, roundNumber, "seating-plan", printout.WriteSeatingPlan)
+}
+
+func (h *PrintoutsHandler) GetScoreSheets(writer http.ResponseWriter, request *http.Request, gameID, roundNumber int) {
+	h.writePDF(writer, request, gameID, roundNumber, "score-sheets", printout.WriteScoreSheets)
+}
+
+func (h *PrintoutsHandler) writePDF(writer http.ResponseWriter, request *http.Request, gameID, roundNumber int, name string, write func(io.Writer, entity.Game, entity.Round) error) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	gameByID, round, err := h.printoutsService.Round(ctx, gameID, roundNumber, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/pdf")
+	writer.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=\"game-%d-round-%d-%s.pdf\"", gameByID.ID, round.RoundNumber, name))
+	writer.WriteHeader(http.StatusOK)
+
+	if err := write(writer, gameByID, round); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/henok321/knobel-manager-service/gen/public"
+	"github.com/henok321/knobel-manager-service/pkg/share"
+)
+
+// PublicHandler serves the read-only views of shared games. Requests are not authenticated; the share
+// token in the path is the only credential.
+type PublicHandler struct {
+	sharesService *share.SharesService
+}
+
+func NewPublicHandler(sharesService *share.SharesService) *PublicHandler {
+	return &PublicHandler{sharesService: sharesService}
+}
+
+func (h *PublicHandler) GetSharedGame(writer http.ResponseWriter, request *http.Request, token string) {
+	ctx := request.Context()
+
+	sharedGame, err := h.sharesService.SharedGame(ctx, token)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	h.writeJSON(writer, request, public.PublicGameResponse{
+		Game: entityGameToPublicGame(sharedGame),
+	})
+}
+
+func (h *PublicHandler) GetSharedStandings(writer http.ResponseWriter, request *http.Request, token string) {
+	ctx := request.Context()
+
+	sharedStandings, err := h.sharesService.SharedStandings(ctx, token)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	h.writeJSON(writer, request, public.PublicStandingsResponse{
+		Standings: standingsToPublicStandings(sharedStandings),
+	})
+}
+
+func (h *PublicHandler) GetSharedRounds(writer http.ResponseWriter, request *http.Request, token string) {
+	ctx := request.Context()
+
+	sharedGame, err := h.sharesService.SharedGame(ctx, token)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	h.writeJSON(writer, request, public.PublicRoundsResponse{
+		Rounds: entityRoundsToPublicRounds(sharedGame),
+	})
+}
+
+// writeJSON writes a public response. Live views are polled, so responses must not be cached.
+func (h *PublicHandler) writeJSON(writer http.ResponseWriter, request *http.Request, response any) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.Header().Set("Cache-Control", "no-store")
+	writer.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(request.Context(), "Could not write body", "error", err)
+	}
+}
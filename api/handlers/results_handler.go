@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/henok321/knobel-manager-service/pkg/results"
+)
+
+const (
+	csvMediaType  = "text/csv"
+	xlsxMediaType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+type ResultsHandler struct {
+	resultsService *results.ResultsService
+}
+
+func NewResultsHandler(resultsService *results.ResultsService) *ResultsHandler {
+	return &ResultsHandler{resultsService: resultsService}
+}
+
+func (h *ResultsHandler) ExportResults(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	mediaType := negotiate(request.Header.Get("Accept"), csvMediaType, xlsxMediaType)
+	if mediaType == "" {
+		JSONError(writer, "Not acceptable", http.StatusNotAcceptable)
+		return
+	}
+
+	workbook, err := h.resultsService.Results(ctx, gameID, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	contentType, extension, write := "text/csv; charset=utf-8", "csv", results.WriteCSV
+	if mediaType == xlsxMediaType {
+		contentType, extension, write = xlsxMediaType, "xlsx", results.WriteXLSX
+	}
+
+	writer.Header().Set("Content-Type", contentType)
+	writer.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"results-%d.%s\"", workbook.GameID, extension))
+	writer.WriteHeader(http.StatusOK)
+
+	if err := write(writer, workbook); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
+
+// negotiate picks the offered media type the Accept header prefers, the first offer if the header is
+// empty, or an empty string if no offer is acceptable. The most specific media range matching an offer
+// decides its quality, and the first offer wins a tie.
+func negotiate(accept string, offers ...string) string {
+	if strings.TrimSpace(accept) == "" {
+		return offers[0]
+	}
+
+	best, bestQuality := "", 0.0
+
+	for _, offer := range offers {
+		quality, specificity := 0.0, -1
+
+		for _, accepted := range strings.Split(accept, ",") {
+			mediaType, params, err := mime.ParseMediaType(accepted)
+			if err != nil {
+				continue
+			}
+
+			matched := specificityOf(mediaType, offer)
+			if matched <= specificity {
+				continue
+			}
+
+			q := 1.0
+			if value, ok := params["q"]; ok {
+				if q, err = strconv.ParseFloat(value, 64); err != nil {
+					continue
+				}
+			}
+
+			quality, specificity = q, matched
+		}
+
+		if quality > bestQuality {
+			best, bestQuality = offer, quality
+		}
+	}
+
+	return best
+}
+
+// specificityOf rates how closely a media range matches an offer: 2 for the offer itself, 1 for its
+// type with a wildcard subtype, 0 for */* and -1 for no match.
+func specificityOf(mediaRange, offer string) int {
+	group, _, _ := strings.Cut(offer, "/")
+
+	switch mediaRange {
+	case offer:
+		return 2
+	case group + "/*":
+		return 1
+	case "*/*":
+		return 0
+	default:
+		return -1
+	}
+}
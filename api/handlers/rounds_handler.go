@@ -0,0 +1,247 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/henok321/knobel-manager-service/gen/api"
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+	"github.com/henok321/knobel-manager-service/pkg/round"
+)
+
+type RoundsHandler struct {
+	roundsService *round.RoundsService
+}
+
+func NewRoundsHandler(roundsService *round.RoundsService) *RoundsHandler {
+	return &RoundsHandler{roundsService: roundsService}
+}
+
+func (h *RoundsHandler) StartRound(writer http.ResponseWriter, request *http.Request, gameID, roundNumber int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	startedRound, err := h.roundsService.StartRound(ctx, gameID, roundNumber, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writeRound(ctx, writer, startedRound, http.StatusOK)
+}
+
+func (h *RoundsHandler) CloseRound(writer http.ResponseWriter, request *http.Request, gameID, roundNumber int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	closedRound, err := h.roundsService.CloseRound(ctx, gameID, roundNumber, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writeRound(ctx, writer, closedRound, http.StatusOK)
+}
+
+func (h *RoundsHandler) ReassignRound(writer http.ResponseWriter, request *http.Request, gameID, roundNumber int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	setupRequest := api.SetupRequest{}
+
+	if err := json.NewDecoder(request.Body).Decode(&setupRequest); err != nil && !errors.Is(err, io.EOF) {
+		JSONError(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	reassignedRound, err := h.roundsService.ReassignRound(ctx, gameID, roundNumber, sub, setupRequest.Seed)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writeRound(ctx, writer, reassignedRound, http.StatusOK)
+}
+
+func (h *RoundsHandler) MovePlayer(writer http.ResponseWriter, request *http.Request, gameID, roundNumber int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	moveRequest := api.SeatMoveRequest{}
+
+	if err := json.NewDecoder(request.Body).Decode(&moveRequest); err != nil {
+		JSONError(writer, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	tables, err := h.roundsService.MoveSeat(ctx, gameID, roundNumber, sub, moveRequest)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writeTables(ctx, writer, tables)
+}
+
+func (h *RoundsHandler) SwapSeats(writer http.ResponseWriter, request *http.Request, gameID, roundNumber int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	swapRequest := api.SeatSwapRequest{}
+
+	if err := json.NewDecoder(request.Body).Decode(&swapRequest); err != nil {
+		JSONError(writer, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if swapRequest.PlayerID == swapRequest.OtherPlayerID {
+		JSONError(writer, "Cannot swap a player with themselves", http.StatusBadRequest)
+		return
+	}
+
+	tables, err := h.roundsService.SwapSeats(ctx, gameID, roundNumber, sub, swapRequest)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writeTables(ctx, writer, tables)
+}
+
+func (h *RoundsHandler) NextRound(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	nextRound, err := h.roundsService.NextRound(ctx, gameID, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writer.Header().Set("Location", fmt.Sprintf("/games/%d/rounds/%d/tables", gameID, nextRound.RoundNumber))
+	writeRound(ctx, writer, nextRound, http.StatusCreated)
+}
+
+func (h *RoundsHandler) SubstitutePlayer(writer http.ResponseWriter, request *http.Request, gameID, roundNumber int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	substitutionRequest := api.SubstitutionRequest{}
+
+	if err := json.NewDecoder(request.Body).Decode(&substitutionRequest); err != nil {
+		JSONError(writer, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	newSubstitute := substitutionRequest.SubstituteName != nil && strings.TrimSpace(*substitutionRequest.SubstituteName) != ""
+	if !substitutionRequest.Scope.Valid() || (substitutionRequest.SubstituteID != nil) == newSubstitute {
+		JSONError(writer, "Invalid substitution request", http.StatusBadRequest)
+		return
+	}
+
+	substitutions, err := h.roundsService.Substitute(ctx, gameID, roundNumber, sub, substitutionRequest)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writeSubstitutions(ctx, writer, substitutions, http.StatusCreated)
+}
+
+func (h *RoundsHandler) GetSubstitutions(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	substitutions, err := h.roundsService.Substitutions(ctx, gameID, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writeSubstitutions(ctx, writer, substitutions, http.StatusOK)
+}
+
+func writeTables(ctx context.Context, writer http.ResponseWriter, tables []entity.GameTable) {
+	response := api.TablesResponse{
+		Tables: make([]api.Table, len(tables)),
+	}
+
+	for i, table := range tables {
+		response.Tables[i] = entityTableToAPITable(table)
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
+
+func writeSubstitutions(ctx context.Context, writer http.ResponseWriter, substitutions []entity.Substitution, status int) {
+	response := api.SubstitutionsResponse{
+		Substitutions: make([]api.Substitution, len(substitutions)),
+	}
+
+	for i, substitution := range substitutions {
+		response.Substitutions[i] = entitySubstitutionToAPI(substitution)
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
+
+func writeRound(ctx context.Context, writer http.ResponseWriter, roundEntity entity.Round, status int) {
+	response := api.RoundResponse{
+		Round: entityRoundToAPIRound(roundEntity),
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
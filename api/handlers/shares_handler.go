@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/henok321/knobel-manager-service/gen/api"
+	"github.com/henok321/knobel-manager-service/pkg/share"
+)
+
+type SharesHandler struct {
+	sharesService *share.SharesService
+}
+
+func NewSharesHandler(sharesService *share.SharesService) *SharesHandler {
+	return &SharesHandler{sharesService: sharesService}
+}
+
+func (h *SharesHandler) GetShare(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	gameShare, err := h.sharesService.Share(ctx, gameID, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	response := api.ShareResponse{
+		Share: entityShareToAPIShare(gameShare),
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
+
+func (h *SharesHandler) CreateShare(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	shareRequest := api.ShareRequest{}
+
+	if err := json.NewDecoder(request.Body).Decode(&shareRequest); err != nil && !errors.Is(err, io.EOF) {
+		JSONError(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	gameShare, err := h.sharesService.CreateShare(ctx, gameID, sub, shareRequest)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	response := api.ShareResponse{
+		Share: entityShareToAPIShare(gameShare),
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusCreated)
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
+
+func (h *SharesHandler) RevokeShare(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	if err := h.sharesService.RevokeShare(ctx, gameID, sub); err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writer.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/henok321/knobel-manager-service/gen/api"
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+	"github.com/henok321/knobel-manager-service/pkg/stage"
+	"github.com/henok321/knobel-manager-service/pkg/standings"
+)
+
+type StagesHandler struct {
+	stagesService    *stage.StagesService
+	standingsService *standings.StandingsService
+}
+
+func NewStagesHandler(stagesService *stage.StagesService, standingsService *standings.StandingsService) *StagesHandler {
+	return &StagesHandler{stagesService: stagesService, standingsService: standingsService}
+}
+
+func (h *StagesHandler) GetStages(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	stages, err := h.stagesService.Stages(ctx, gameID, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writeStages(ctx, writer, stages)
+}
+
+func (h *StagesHandler) UpdateStages(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	stagesRequest := api.StagesRequest{}
+
+	if err := json.NewDecoder(request.Body).Decode(&stagesRequest); err != nil {
+		JSONError(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	stages, err := h.stagesService.ConfigureStages(ctx, gameID, sub, stagesRequest)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	writeStages(ctx, writer, stages)
+}
+
+func (h *StagesHandler) AdvanceStage(writer http.ResponseWriter, request *http.Request, gameID int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	setupRequest := api.SetupRequest{}
+
+	if err := json.NewDecoder(request.Body).Decode(&setupRequest); err != nil && !errors.Is(err, io.EOF) {
+		JSONError(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	advanced, err := h.stagesService.Advance(ctx, gameID, sub, setupRequest.Seed)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	response := api.StageResponse{
+		Stage: entityStageToAPIStage(advanced),
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
+
+func (h *StagesHandler) GetStageStandings(writer http.ResponseWriter, request *http.Request, gameID, position int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	stageStandings, err := h.standingsService.StageStandings(ctx, gameID, position, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	response := api.StandingsResponse{
+		Standings: standingsToAPIStandings(stageStandings),
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
+
+func writeStages(ctx context.Context, writer http.ResponseWriter, stages []*entity.Stage) {
+	response := api.StagesResponse{
+		Stages: make([]api.Stage, len(stages)),
+	}
+
+	for i, stageEntity := range stages {
+		response.Stages[i] = entityStageToAPIStage(*stageEntity)
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
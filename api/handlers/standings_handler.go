@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/henok321/knobel-manager-service/gen/api"
+	"github.com/henok321/knobel-manager-service/pkg/standings"
+)
+
+type StandingsHandler struct {
+	standingsService *standings.StandingsService
+}
+
+func NewStandingsHandler(standingsService *standings.StandingsService) *StandingsHandler {
+	return &StandingsHandler{standingsService: standingsService}
+}
+
+func (h *StandingsHandler) GetStandings(writer http.ResponseWriter, request *http.Request, gameID int, params api.GetStandingsParams) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	afterRound := 0
+
+	if params.AfterRound != nil {
+		if *params.AfterRound < 1 {
+			JSONError(writer, "afterRound must be positive", http.StatusBadRequest)
+			return
+		}
+
+		afterRound = *params.AfterRound
+	}
+
+	gameStandings, err := h.standingsService.Standings(ctx, gameID, afterRound, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	response := api.StandingsResponse{
+		Standings: standingsToAPIStandings(gameStandings),
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
@@ -165,3 +165,66 @@ func (t *TablesHandler) UpdateScores(writer http.ResponseWriter, request *http.R
 		slog.ErrorContext(ctx, "Could not write body", "error", err)
 	}
 }
+
+func (t *TablesHandler) GetScoreHistory(writer http.ResponseWriter, request *http.Request, gameID, roundNumber, tableNumber int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	history, err := t.tablesService.ScoreHistory(ctx, gameID, roundNumber, tableNumber, sub)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	response := api.ScoreHistoryResponse{
+		History: make([]api.ScoreHistoryEntry, len(history)),
+	}
+
+	for i, entry := range history {
+		response.History[i] = entityScoreHistoryToAPI(entry)
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
+
+func (t *TablesHandler) UpdateSeatOrder(writer http.ResponseWriter, request *http.Request, gameID, roundNumber, tableNumber int) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	seatOrderRequest := api.SeatOrderRequest{}
+
+	if err := json.NewDecoder(request.Body).Decode(&seatOrderRequest); err != nil {
+		JSONError(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	updatedTable, err := t.tablesService.UpdateSeatOrder(ctx, gameID, roundNumber, tableNumber, sub, seatOrderRequest)
+	if err != nil {
+		respondError(writer, err)
+		return
+	}
+
+	response := api.TableResponse{
+		Table: entityTableToAPITable(updatedTable),
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(ctx, "Could not write body", "error", err)
+	}
+}
@@ -2,9 +2,11 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 	"strconv"
+	"unicode/utf8"
 
 	"github.com/henok321/knobel-manager-service/gen/api"
 	"github.com/henok321/knobel-manager-service/pkg/team"
@@ -57,6 +59,66 @@ func (t *TeamsHandler) CreateTeam(writer http.ResponseWriter, request *http.Requ
 	}
 }
 
+func (t *TeamsHandler) ImportTeams(writer http.ResponseWriter, request *http.Request, gameID int, params api.ImportTeamsParams) {
+	ctx := request.Context()
+
+	sub, ok := userSub(writer, request)
+	if !ok {
+		return
+	}
+
+	delimiter := ','
+	if params.Delimiter != nil {
+		runes := []rune(*params.Delimiter)
+		if len(runes) != 1 || runes[0] == '"' || runes[0] == '\r' || runes[0] == '\n' || runes[0] == utf8.RuneError {
+			JSONError(writer, "Invalid delimiter", http.StatusBadRequest)
+			return
+		}
+
+		delimiter = runes[0]
+	}
+
+	header := params.Header != nil && *params.Header
+
+	importedTeams, err := t.service.ImportTeams(ctx, gameID, sub, request.Body, delimiter, header)
+	if err != nil {
+		var importErr *team.ImportError
+		if errors.As(err, &importErr) {
+			writeImportError(writer, importErr)
+			return
+		}
+
+		respondError(writer, err)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusCreated)
+
+	response := api.TeamsImportResponse{Teams: make([]api.Team, len(importedTeams))}
+	for i, importedTeam := range importedTeams {
+		response.Teams[i] = entityTeamToAPITeam(importedTeam)
+	}
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.InfoContext(ctx, "Could not write body", "error", err)
+	}
+}
+
+func writeImportError(writer http.ResponseWriter, importErr *team.ImportError) {
+	response := api.ImportErrorResponse{Error: "Invalid lines", Lines: make([]api.ImportLineError, len(importErr.Lines))}
+	for i, line := range importErr.Lines {
+		response.Lines[i] = api.ImportLineError{Line: line.Line, Message: line.Message}
+	}
+
+	writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+	writer.WriteHeader(http.StatusUnprocessableEntity)
+
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		slog.Error("Failed to encode error response", "error", err)
+	}
+}
+
 func (t *TeamsHandler) UpdateTeam(writer http.ResponseWriter, request *http.Request, gameID, teamID int) {
 	ctx := request.Context()
 
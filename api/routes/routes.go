@@ -12,8 +12,16 @@ import (
 	"github.com/henok321/knobel-manager-service/api/middleware"
 	"github.com/henok321/knobel-manager-service/gen/api"
 	"github.com/henok321/knobel-manager-service/gen/health"
+	publicapi "github.com/henok321/knobel-manager-service/gen/public"
+	"github.com/henok321/knobel-manager-service/pkg/final"
 	"github.com/henok321/knobel-manager-service/pkg/game"
 	"github.com/henok321/knobel-manager-service/pkg/player"
+	"github.com/henok321/knobel-manager-service/pkg/printout"
+	"github.com/henok321/knobel-manager-service/pkg/results"
+	"github.com/henok321/knobel-manager-service/pkg/round"
+	"github.com/henok321/knobel-manager-service/pkg/share"
+	"github.com/henok321/knobel-manager-service/pkg/stage"
+	"github.com/henok321/knobel-manager-service/pkg/standings"
 	"github.com/henok321/knobel-manager-service/pkg/table"
 	"github.com/henok321/knobel-manager-service/pkg/team"
 )
@@ -23,6 +31,13 @@ type apiServer struct {
 	*handlers.TeamsHandler
 	*handlers.PlayersHandler
 	*handlers.TablesHandler
+	*handlers.StandingsHandler
+	*handlers.RoundsHandler
+	*handlers.FinalsHandler
+	*handlers.StagesHandler
+	*handlers.ResultsHandler
+	*handlers.PrintoutsHandler
+	*handlers.SharesHandler
 }
 
 var _ api.ServerInterface = (*apiServer)(nil)
@@ -47,11 +62,11 @@ func serveBytes(contentType string, body []byte) http.HandlerFunc {
 }
 
 func SetupRouter(database *gorm.DB, authClient middleware.FirebaseAuth, healthService *healthpkg.Service, openAPIConfig, swaggerDocs []byte) *http.ServeMux {
-	public := func(csp string) func(http.Handler) http.Handler {
+	public := func(csp string, logLevel slog.Level) func(http.Handler) http.Handler {
 		return chain(
 			middleware.SecurityHeaders(csp),
 			middleware.Metrics(),
-			middleware.RequestLogging(slog.LevelDebug),
+			middleware.RequestLogging(logLevel),
 		)
 	}
 
@@ -63,20 +78,35 @@ func SetupRouter(database *gorm.DB, authClient middleware.FirebaseAuth, healthSe
 	)
 
 	gameService := game.NewGamesService(game.NewGamesRepository(database), authClient)
-	playerService := player.NewPlayersService(player.NewPlayersRepository(database), team.NewTeamsRepository(database))
+	playerService := player.NewPlayersService(player.NewPlayersRepository(database), team.NewTeamsRepository(database), gameService)
 	tableService := table.NewTablesService(table.NewTablesRepository(database))
 	teamService := team.NewTeamsService(team.NewTeamsRepository(database), gameService)
+	standingsService := standings.NewStandingsService(gameService)
+	roundService := round.NewRoundsService(round.NewRoundsRepository(database), gameService)
+	finalService := final.NewFinalsService(final.NewFinalsRepository(database), gameService)
+	stageService := stage.NewStagesService(gameService)
+	resultsService := results.NewResultsService(gameService)
+	printoutsService := printout.NewPrintoutsService(gameService)
+	sharesService := share.NewSharesService(share.NewSharesRepository(database), game.NewGamesRepository(database), gameService)
 
 	healthHandler := handlers.NewHealthHandler(healthService)
 	gamesHandler := handlers.NewGamesHandler(gameService, authClient)
 	playersHandler := handlers.NewPlayersHandler(playerService)
 	tablesHandler := handlers.NewTablesHandler(gameService, tableService)
 	teamsHandler := handlers.NewTeamsHandler(teamService)
+	standingsHandler := handlers.NewStandingsHandler(standingsService)
+	roundsHandler := handlers.NewRoundsHandler(roundService)
+	finalsHandler := handlers.NewFinalsHandler(finalService, standingsService)
+	stagesHandler := handlers.NewStagesHandler(stageService, standingsService)
+	resultsHandler := handlers.NewResultsHandler(resultsService)
+	printoutsHandler := handlers.NewPrintoutsHandler(printoutsService)
+	sharesHandler := handlers.NewSharesHandler(sharesService)
+	publicHandler := handlers.NewPublicHandler(sharesService)
 
 	router := http.NewServeMux()
 
-	router.Handle("/openapi.yaml", public("default-src 'self'")(serveBytes("text/yaml; charset=utf-8", openAPIConfig)))
-	router.Handle("/docs", public("default-src 'self'; style-src 'self' https://unpkg.com; script-src 'self' https://unpkg.com 'unsafe-inline'; img-src 'self' data:")(serveBytes("text/html; charset=utf-8", swaggerDocs)))
+	router.Handle("/openapi.yaml", public("default-src 'self'", slog.LevelDebug)(serveBytes("text/yaml; charset=utf-8", openAPIConfig)))
+	router.Handle("/docs", public("default-src 'self'; style-src 'self' https://unpkg.com; script-src 'self' https://unpkg.com 'unsafe-inline'; img-src 'self' data:", slog.LevelDebug)(serveBytes("text/html; charset=utf-8", swaggerDocs)))
 
 	handleValidationErrors := func(w http.ResponseWriter, _ *http.Request, err error) {
 		handlers.JSONError(w, err.Error(), http.StatusBadRequest)
@@ -84,14 +114,20 @@ func SetupRouter(database *gorm.DB, authClient middleware.FirebaseAuth, healthSe
 
 	health.HandlerWithOptions(healthHandler, health.StdHTTPServerOptions{
 		BaseRouter:  router,
-		Middlewares: []health.MiddlewareFunc{public("default-src 'self'")},
+		Middlewares: []health.MiddlewareFunc{public("default-src 'self'", slog.LevelDebug)},
 	})
 
-	api.HandlerWithOptions(&apiServer{gamesHandler, teamsHandler, playersHandler, tablesHandler}, api.StdHTTPServerOptions{
+	api.HandlerWithOptions(&apiServer{gamesHandler, teamsHandler, playersHandler, tablesHandler, standingsHandler, roundsHandler, finalsHandler, stagesHandler, resultsHandler, printoutsHandler, sharesHandler}, api.StdHTTPServerOptions{
 		BaseRouter:       router,
 		ErrorHandlerFunc: handleValidationErrors,
 		Middlewares:      []api.MiddlewareFunc{authenticated},
 	})
 
+	publicapi.HandlerWithOptions(publicHandler, publicapi.StdHTTPServerOptions{
+		BaseRouter:       router,
+		ErrorHandlerFunc: handleValidationErrors,
+		Middlewares:      []publicapi.MiddlewareFunc{public("default-src 'self'", slog.LevelInfo)},
+	})
+
 	return router
 }
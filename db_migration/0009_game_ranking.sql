@@ -0,0 +1,6 @@
+-- +goose Up
+
+ALTER TABLE games
+ADD COLUMN score_direction VARCHAR(50) NOT NULL DEFAULT 'higher_wins',
+ADD COLUMN tie_breakers VARCHAR(255) NOT NULL DEFAULT '',
+ADD COLUMN coin_flip_seed BIGINT NOT NULL DEFAULT 0;
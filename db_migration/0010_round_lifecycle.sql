@@ -0,0 +1,8 @@
+-- +goose Up
+
+UPDATE rounds
+SET status = 'setup'
+WHERE status NOT IN ('setup', 'in_progress', 'completed');
+
+CREATE UNIQUE INDEX idx_rounds_single_in_progress ON rounds (game_id)
+WHERE status = 'in_progress';
@@ -0,0 +1,20 @@
+-- +goose Up
+
+CREATE TABLE score_history
+(
+    id SERIAL PRIMARY KEY,
+    table_id INTEGER REFERENCES game_tables (id) ON DELETE SET NULL,
+    game_id INTEGER NOT NULL REFERENCES games (id) ON DELETE CASCADE,
+    round_number INTEGER NOT NULL,
+    table_number INTEGER NOT NULL,
+    player_id INTEGER REFERENCES players (id) ON DELETE SET NULL,
+    player_name VARCHAR(255) NOT NULL,
+    old_score INTEGER,
+    new_score INTEGER NOT NULL,
+    owner_sub VARCHAR(255) NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_score_history_table_id ON score_history (table_id);
+CREATE INDEX idx_score_history_game_round_table ON score_history (game_id, round_number, table_number);
@@ -0,0 +1,4 @@
+-- +goose Up
+
+ALTER TABLE games
+ADD COLUMN pairing_mode VARCHAR(50) NOT NULL DEFAULT 'random';
@@ -0,0 +1,5 @@
+-- +goose Up
+
+ALTER TABLE games
+ADD COLUMN draw_seed BIGINT,
+ADD COLUMN draw_algorithm VARCHAR(50);
@@ -0,0 +1,13 @@
+-- +goose Up
+
+ALTER TABLE games
+ADD COLUMN short_tables BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN byes BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN bye_score INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE round_byes
+(
+    round_id INTEGER NOT NULL REFERENCES rounds (id) ON DELETE CASCADE,
+    player_id INTEGER NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    PRIMARY KEY (round_id, player_id)
+);
@@ -0,0 +1,13 @@
+-- +goose Up
+
+ALTER TABLE games
+ADD COLUMN table_layout VARCHAR(255) NOT NULL DEFAULT '';
+
+ALTER TABLE game_tables
+ADD COLUMN capacity INTEGER NOT NULL DEFAULT 0;
+
+UPDATE game_tables
+SET capacity = games.table_size
+FROM rounds
+JOIN games ON games.id = rounds.game_id
+WHERE rounds.id = game_tables.round_id;
@@ -0,0 +1,16 @@
+-- +goose Up
+
+ALTER TABLE players
+ADD COLUMN substitute BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE substitutions
+(
+    id SERIAL PRIMARY KEY,
+    round_id INTEGER NOT NULL REFERENCES rounds (id) ON DELETE CASCADE,
+    game_table_id INTEGER NOT NULL REFERENCES game_tables (id) ON DELETE CASCADE,
+    player_id INTEGER NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    substitute_id INTEGER NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_substitutions_round_id ON substitutions (round_id);
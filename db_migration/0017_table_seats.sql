@@ -0,0 +1,16 @@
+-- +goose Up
+
+ALTER TABLE table_players
+ADD COLUMN seat INTEGER NOT NULL DEFAULT 0;
+
+UPDATE table_players
+SET seat = numbered.seat
+FROM (
+    SELECT
+        game_table_id,
+        player_id,
+        ROW_NUMBER() OVER (PARTITION BY game_table_id ORDER BY player_id) AS seat
+    FROM table_players
+) AS numbered
+WHERE numbered.game_table_id = table_players.game_table_id
+AND numbered.player_id = table_players.player_id;
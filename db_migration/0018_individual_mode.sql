@@ -0,0 +1,11 @@
+-- +goose Up
+
+ALTER TABLE games
+ADD COLUMN game_mode VARCHAR(50) NOT NULL DEFAULT 'team';
+
+ALTER TABLE players
+ADD COLUMN game_id INTEGER REFERENCES games (id) ON DELETE CASCADE,
+ALTER COLUMN team_id DROP NOT NULL,
+ADD CONSTRAINT chk_player_team_or_game CHECK ((team_id IS NULL) <> (game_id IS NULL));
+
+CREATE INDEX idx_players_game_id ON players (game_id);
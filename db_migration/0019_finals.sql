@@ -0,0 +1,33 @@
+-- +goose Up
+
+CREATE TABLE finals
+(
+    id SERIAL PRIMARY KEY,
+    game_id INTEGER NOT NULL REFERENCES games (id) ON DELETE CASCADE,
+    finalists INTEGER NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT unique_final_game UNIQUE (game_id)
+);
+
+CREATE TABLE final_tables
+(
+    id SERIAL PRIMARY KEY,
+    final_id INTEGER NOT NULL REFERENCES finals (id) ON DELETE CASCADE,
+    table_number INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT unique_final_table UNIQUE (final_id, table_number)
+);
+
+CREATE TABLE final_players
+(
+    final_table_id INTEGER NOT NULL REFERENCES final_tables (id) ON DELETE CASCADE,
+    player_id INTEGER NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    seed INTEGER NOT NULL,
+    score INTEGER,
+    PRIMARY KEY (final_table_id, player_id)
+);
+
+CREATE INDEX idx_final_tables_final_id ON final_tables (final_id);
@@ -0,0 +1,37 @@
+-- +goose Up
+
+CREATE TABLE stages
+(
+    id SERIAL PRIMARY KEY,
+    game_id INTEGER NOT NULL REFERENCES games (id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    stage_name VARCHAR(255) NOT NULL,
+    pairing_mode VARCHAR(50) NOT NULL DEFAULT 'random',
+    number_of_rounds INTEGER NOT NULL,
+    group_count INTEGER NOT NULL DEFAULT 1,
+    qualification VARCHAR(50) NOT NULL DEFAULT 'all',
+    qualifiers INTEGER NOT NULL DEFAULT 0,
+    carry_over VARCHAR(50) NOT NULL DEFAULT 'none',
+    status VARCHAR(50) NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT unique_game_stage UNIQUE (game_id, position)
+);
+
+CREATE TABLE stage_players
+(
+    stage_id INTEGER NOT NULL REFERENCES stages (id) ON DELETE CASCADE,
+    player_id INTEGER NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    group_number INTEGER NOT NULL DEFAULT 1,
+    carried_score INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (stage_id, player_id)
+);
+
+ALTER TABLE rounds
+ADD COLUMN stage_id INTEGER REFERENCES stages (id) ON DELETE CASCADE;
+
+ALTER TABLE game_tables
+ADD COLUMN stage_id INTEGER REFERENCES stages (id) ON DELETE CASCADE;
+
+CREATE INDEX idx_rounds_stage_id ON rounds (stage_id);
+CREATE INDEX idx_game_tables_stage_id ON game_tables (stage_id);
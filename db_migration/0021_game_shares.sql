@@ -0,0 +1,9 @@
+-- +goose Up
+
+CREATE TABLE game_shares
+(
+    game_id INTEGER PRIMARY KEY REFERENCES games (id) ON DELETE CASCADE,
+    token VARCHAR(64) NOT NULL UNIQUE,
+    abbreviate_names BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
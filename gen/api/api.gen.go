@@ -6,12 +6,68 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for CarryOver.
+const (
+	Full CarryOver = "full"
+	None CarryOver = "none"
+)
+
+// Valid indicates whether the value is a known member of the CarryOver enum.
+func (e CarryOver) Valid() bool {
+	switch e {
+	case Full:
+		return true
+	case None:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for FinalStatus.
+const (
+	FinalStatusCompleted  FinalStatus = "completed"
+	FinalStatusInProgress FinalStatus = "in_progress"
+)
+
+// Valid indicates whether the value is a known member of the FinalStatus enum.
+func (e FinalStatus) Valid() bool {
+	switch e {
+	case FinalStatusCompleted:
+		return true
+	case FinalStatusInProgress:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GameMode.
+const (
+	GameModeIndividual GameMode = "individual"
+	GameModeTeam       GameMode = "team"
+)
+
+// Valid indicates whether the value is a known member of the GameMode enum.
+func (e GameMode) Valid() bool {
+	switch e {
+	case GameModeIndividual:
+		return true
+	case GameModeTeam:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for GameStatus.
 const (
 	GameStatusCompleted  GameStatus = "completed"
@@ -33,6 +89,45 @@ func (e GameStatus) Valid() bool {
 	}
 }
 
+// Defines values for PairingMode.
+const (
+	Random PairingMode = "random"
+	Swiss  PairingMode = "swiss"
+)
+
+// Valid indicates whether the value is a known member of the PairingMode enum.
+func (e PairingMode) Valid() bool {
+	switch e {
+	case Random:
+		return true
+	case Swiss:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for QualificationRule.
+const (
+	All         QualificationRule = "all"
+	TopOverall  QualificationRule = "top_overall"
+	TopPerGroup QualificationRule = "top_per_group"
+)
+
+// Valid indicates whether the value is a known member of the QualificationRule enum.
+func (e QualificationRule) Valid() bool {
+	switch e {
+	case All:
+		return true
+	case TopOverall:
+		return true
+	case TopPerGroup:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for RoundStatus.
 const (
 	RoundStatusCompleted  RoundStatus = "completed"
@@ -54,171 +149,1245 @@ func (e RoundStatus) Valid() bool {
 	}
 }
 
+// Defines values for ScoreDirection.
+const (
+	HigherWins ScoreDirection = "higher_wins"
+	LowerWins  ScoreDirection = "lower_wins"
+)
+
+// Valid indicates whether the value is a known member of the ScoreDirection enum.
+func (e ScoreDirection) Valid() bool {
+	switch e {
+	case HigherWins:
+		return true
+	case LowerWins:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for StageStatus.
+const (
+	StageStatusCompleted  StageStatus = "completed"
+	StageStatusInProgress StageStatus = "in_progress"
+	StageStatusPending    StageStatus = "pending"
+)
+
+// Valid indicates whether the value is a known member of the StageStatus enum.
+func (e StageStatus) Valid() bool {
+	switch e {
+	case StageStatusCompleted:
+		return true
+	case StageStatusInProgress:
+		return true
+	case StageStatusPending:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for SubstitutionScope.
+const (
+	Remaining SubstitutionScope = "remaining"
+	Round     SubstitutionScope = "round"
+)
+
+// Valid indicates whether the value is a known member of the SubstitutionScope enum.
+func (e SubstitutionScope) Valid() bool {
+	switch e {
+	case Remaining:
+		return true
+	case Round:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for TieBreaker.
+const (
+	BestRound        TieBreaker = "best_round"
+	CoinFlip         TieBreaker = "coin_flip"
+	FewestZeroRounds TieBreaker = "fewest_zero_rounds"
+	HeadToHead       TieBreaker = "head_to_head"
+	TableWins        TieBreaker = "table_wins"
+)
+
+// Valid indicates whether the value is a known member of the TieBreaker enum.
+func (e TieBreaker) Valid() bool {
+	switch e {
+	case BestRound:
+		return true
+	case CoinFlip:
+		return true
+	case FewestZeroRounds:
+		return true
+	case HeadToHead:
+		return true
+	case TableWins:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ViolationType.
+const (
+	SeatedTwice      ViolationType = "seated_twice"
+	TeammatesAtTable ViolationType = "teammates_at_table"
+)
+
+// Valid indicates whether the value is a known member of the ViolationType enum.
+func (e ViolationType) Valid() bool {
+	switch e {
+	case SeatedTwice:
+		return true
+	case TeammatesAtTable:
+		return true
+	default:
+		return false
+	}
+}
+
 // AddOwnerRequest defines model for AddOwnerRequest.
 type AddOwnerRequest struct {
 	// Email Example: owner@example.org
 	Email string `json:"email"`
 }
 
-// Error defines model for Error.
-type Error struct {
-	Error string `json:"error"`
+// ArchiveFinal defines model for ArchiveFinal.
+type ArchiveFinal struct {
+	// Finalists Example: 8
+	Finalists int `json:"finalists"`
+
+	// Status Example: in_progress
+	Status FinalStatus          `json:"status"`
+	Tables *[]ArchiveFinalTable `json:"tables,omitempty"`
 }
 
-// Game defines model for Game.
-type Game struct {
-	// Id Example: 1
-	Id int `json:"id"`
+// ArchiveFinalTable defines model for ArchiveFinalTable.
+type ArchiveFinalTable struct {
+	Players *[]FinalPlayer `json:"players,omitempty"`
+
+	// TableNumber Example: 1
+	TableNumber int `json:"tableNumber"`
+}
+
+// ArchiveGame defines model for ArchiveGame.
+type ArchiveGame struct {
+	// Draw Seed and algorithm version of the last table draw.
+	Draw  *Draw         `json:"draw,omitempty"`
+	Final *ArchiveFinal `json:"final,omitempty"`
+
+	// Mode team games draw teams of players and rank teams and players; individual games register players directly against the game and rank players only.
+	//
+	// Example: team
+	Mode GameMode `json:"mode"`
 
 	// Name Example: Game 1
 	Name string `json:"name"`
 
 	// NumberOfRounds Example: 2
-	NumberOfRounds int          `json:"numberOfRounds"`
-	Owners         []GameOwner  `json:"owners"`
-	Rounds         *[]GameRound `json:"rounds,omitempty"`
+	NumberOfRounds int `json:"numberOfRounds"`
+
+	// PairingMode random draws all rounds at setup; swiss draws round 1 at setup and every further round from the current standings.
+	//
+	// Example: random
+	PairingMode PairingMode `json:"pairingMode"`
+
+	// Players Players of an individual game.
+	Players *[]ArchivePlayer `json:"players,omitempty"`
+	Ranking Ranking          `json:"ranking"`
+	Rounds  *[]ArchiveRound  `json:"rounds,omitempty"`
+
+	// Seating How players are seated when they do not fill all tables.
+	Seating Seating  `json:"seating"`
+	Stages  *[]Stage `json:"stages,omitempty"`
 
 	// Status Example: setup
 	Status GameStatus `json:"status"`
 
+	// TableLayout Seats of every table in a round, overriding the table size. Tables may differ in size.
+	//
+	// Example: [5,5,4]
+	TableLayout *TableLayout `json:"tableLayout,omitempty"`
+
 	// TableSize Example: 4
 	TableSize int `json:"tableSize"`
 
 	// TeamSize Example: 4
-	TeamSize int     `json:"teamSize"`
-	Teams    *[]Team `json:"teams,omitempty"`
+	TeamSize int            `json:"teamSize"`
+	Teams    *[]ArchiveTeam `json:"teams,omitempty"`
 }
 
-// GameCreateRequest defines model for GameCreateRequest.
-type GameCreateRequest struct {
-	Name           string `json:"name"`
-	NumberOfRounds int    `json:"numberOfRounds"`
-	TableSize      int    `json:"tableSize"`
-	TeamSize       int    `json:"teamSize"`
+// ArchivePlayer defines model for ArchivePlayer.
+type ArchivePlayer struct {
+	// Id Example: 1
+	Id int `json:"id"`
+
+	// Name Example: Player 1
+	Name       string `json:"name"`
+	Substitute *bool  `json:"substitute,omitempty"`
 }
 
-// GameOwner defines model for GameOwner.
-type GameOwner struct {
-	// Email Resolved live from Firebase; absent if the user cannot be resolved.
+// ArchiveRound defines model for ArchiveRound.
+type ArchiveRound struct {
+	// Byes IDs of the players sitting the round out.
 	//
-	// Example: owner@example.org
-	Email *string `json:"email,omitempty"`
+	// Example: [17]
+	Byes *[]int `json:"byes,omitempty"`
 
-	// GameID Example: 1
-	GameID int `json:"gameID"`
+	// RoundNumber Example: 1
+	RoundNumber int `json:"roundNumber"`
 
-	// OwnerSub Example: sub-1
-	OwnerSub string `json:"ownerSub"`
+	// StageID Stage the round belongs to.
+	//
+	// Example: 1
+	StageID *int `json:"stageID,omitempty"`
+
+	// Status Example: in_progress
+	Status        RoundStatus            `json:"status"`
+	Substitutions *[]ArchiveSubstitution `json:"substitutions,omitempty"`
+	Tables        *[]ArchiveTable        `json:"tables,omitempty"`
 }
 
-// GameResponse defines model for GameResponse.
-type GameResponse struct {
-	Game Game `json:"game"`
+// ArchiveScore defines model for ArchiveScore.
+type ArchiveScore struct {
+	// PlayerID Example: 1
+	PlayerID int `json:"playerID"`
+
+	// Score Example: 6
+	Score int `json:"score"`
 }
 
-// GameRound Round skeleton returned as part of game structure. Tables and scores are loaded lazily via the per-round tables endpoints, not embedded here.
-type GameRound struct {
-	GameID      int `json:"gameID"`
-	Id          int `json:"id"`
-	RoundNumber int `json:"roundNumber"`
+// ArchiveSeat defines model for ArchiveSeat.
+type ArchiveSeat struct {
+	// PlayerID Example: 1
+	PlayerID int `json:"playerID"`
 
-	// Status Example: in_progress
-	Status RoundStatus `json:"status"`
+	// Seat Example: 1
+	Seat int `json:"seat"`
 }
 
-// GameStatus Example: setup
-type GameStatus string
+// ArchiveSubstitution defines model for ArchiveSubstitution.
+type ArchiveSubstitution struct {
+	// PlayerID Example: 1
+	PlayerID int `json:"playerID"`
 
-// GameUpdateRequest defines model for GameUpdateRequest.
-type GameUpdateRequest struct {
-	Name           string `json:"name"`
-	NumberOfRounds int    `json:"numberOfRounds"`
+	// SubstituteID Example: 5
+	SubstituteID int `json:"substituteID"`
 
-	// Status Example: setup
-	Status    GameStatus `json:"status"`
-	TableSize int        `json:"tableSize"`
-	TeamSize  int        `json:"teamSize"`
+	// TableNumber Example: 1
+	TableNumber int `json:"tableNumber"`
 }
 
-// GamesResponse defines model for GamesResponse.
-type GamesResponse struct {
-	Games []Game `json:"games"`
+// ArchiveTable defines model for ArchiveTable.
+type ArchiveTable struct {
+	// Capacity Example: 4
+	Capacity int             `json:"capacity"`
+	Scores   *[]ArchiveScore `json:"scores,omitempty"`
+	Seats    *[]ArchiveSeat  `json:"seats,omitempty"`
+
+	// StageID Example: 1
+	StageID *int `json:"stageID,omitempty"`
+
+	// TableNumber Example: 1
+	TableNumber int `json:"tableNumber"`
 }
 
-// Player defines model for Player.
-type Player struct {
+// ArchiveTeam defines model for ArchiveTeam.
+type ArchiveTeam struct {
 	// Id Example: 1
 	Id int `json:"id"`
 
-	// Name Example: Player 1
-	Name string `json:"name"`
-
-	// TeamID Example: 1
-	TeamID int `json:"teamID"`
+	// Name Example: Team 1
+	Name    string           `json:"name"`
+	Players *[]ArchivePlayer `json:"players,omitempty"`
 }
 
-// PlayersRequest defines model for PlayersRequest.
-type PlayersRequest struct {
-	Name string `json:"name"`
+// CarryOver none starts every qualifier at zero; full carries the total score of the previous stage over.
+//
+// Example: none
+type CarryOver string
+
+// Draw Seed and algorithm version of the last table draw.
+type Draw struct {
+	// Algorithm Example: assign-rounds-v1
+	Algorithm string `json:"algorithm"`
+
+	// Seed Example: 1718000000
+	Seed int64 `json:"seed"`
 }
 
-// PlayersResponse defines model for PlayersResponse.
-type PlayersResponse struct {
-	Player Player `json:"player"`
+// DrawMismatch defines model for DrawMismatch.
+type DrawMismatch struct {
+	// RoundNumber Example: 1
+	RoundNumber int `json:"roundNumber"`
+
+	// TableNumber Example: 2
+	TableNumber int `json:"tableNumber"`
 }
 
-// RoundStatus Example: in_progress
-type RoundStatus string
+// DrawQuality How evenly the encounters of a draw are spread over all rounds.
+type DrawQuality struct {
+	// Cost Weighted penalty of repeated encounters; lower is better.
+	//
+	// Example: 4
+	Cost int `json:"cost"`
 
-// Score defines model for Score.
-type Score struct {
-	// Id Example: 100
-	Id int `json:"id"`
+	// MaxPlayerEncounters Example: 1
+	MaxPlayerEncounters int `json:"maxPlayerEncounters"`
 
-	// PlayerID Example: 1
-	PlayerID int `json:"playerID"`
+	// MaxTeamEncounters Example: 2
+	MaxTeamEncounters int `json:"maxTeamEncounters"`
 
-	// Score Example: 6
-	Score int `json:"score"`
+	// PlayerRepeats Encounters of player pairs beyond their first one.
+	//
+	// Example: 0
+	PlayerRepeats int `json:"playerRepeats"`
 
-	// TableID Example: 10
-	TableID int `json:"tableID"`
+	// TeamRepeats Encounters of team pairs beyond their first one.
+	//
+	// Example: 4
+	TeamRepeats int `json:"teamRepeats"`
 }
 
-// ScoresRequest defines model for ScoresRequest.
-type ScoresRequest struct {
-	Scores []struct {
-		PlayerID int `json:"playerID"`
-		Score    int `json:"score"`
-	} `json:"scores"`
-}
+// DrawVerification defines model for DrawVerification.
+type DrawVerification struct {
+	// Algorithm Example: assign-rounds-v1
+	Algorithm string `json:"algorithm"`
 
-// Table defines model for Table.
-type Table struct {
-	// Id Example: 10
-	Id      int       `json:"id"`
-	Players *[]Player `json:"players,omitempty"`
+	// Matches True if every table drawn from the seed has the same players as the assigned table.
+	Matches    bool           `json:"matches"`
+	Mismatches []DrawMismatch `json:"mismatches"`
 
-	// RoundID Example: 5
-	RoundID int      `json:"roundID"`
-	Scores  *[]Score `json:"scores,omitempty"`
+	// Seed Example: 1718000000
+	Seed int64 `json:"seed"`
+}
 
-	// TableNumber Example: 1
-	TableNumber int `json:"tableNumber"`
+// DrawVerificationResponse defines model for DrawVerificationResponse.
+type DrawVerificationResponse struct {
+	Verification DrawVerification `json:"verification"`
 }
 
-// TableResponse defines model for TableResponse.
-type TableResponse struct {
-	Table Table `json:"table"`
+// EncounterMatrix counts[i][j] is how often ids[i] and ids[j] sat at the same table.
+type EncounterMatrix struct {
+	Counts [][]int `json:"counts"`
+	Ids    []int   `json:"ids"`
 }
 
-// TablesResponse defines model for TablesResponse.
-type TablesResponse struct {
-	Tables []Table `json:"tables"`
+// Error defines model for Error.
+type Error struct {
+	Error string `json:"error"`
 }
 
-// Team defines model for Team.
-type Team struct {
+// Final defines model for Final.
+type Final struct {
+	// Finalists Example: 8
+	Finalists int `json:"finalists"`
+
+	// GameID Example: 1
+	GameID int `json:"gameID"`
+
+	// Id Example: 1
+	Id int `json:"id"`
+
+	// Status Example: in_progress
+	Status FinalStatus  `json:"status"`
+	Tables []FinalTable `json:"tables"`
+}
+
+// FinalCreateRequest defines model for FinalCreateRequest.
+type FinalCreateRequest struct {
+	// Finalists Number of the best players of the preliminary standings playing the final.
+	//
+	// Example: 8
+	Finalists int `json:"finalists"`
+}
+
+// FinalPlayer defines model for FinalPlayer.
+type FinalPlayer struct {
+	// PlayerID Example: 1
+	PlayerID int `json:"playerID"`
+
+	// Score Final score; absent until the table has been scored.
+	//
+	// Example: 8
+	Score *int `json:"score,omitempty"`
+
+	// Seed Place of the finalist in the preliminary standings.
+	//
+	// Example: 1
+	Seed int `json:"seed"`
+}
+
+// FinalRanking defines model for FinalRanking.
+type FinalRanking struct {
+	// Complete False while a finalist misses a final score.
+	Complete bool `json:"complete"`
+
+	// GameID Example: 1
+	GameID  int             `json:"gameID"`
+	Players []FinalStanding `json:"players"`
+}
+
+// FinalRankingResponse defines model for FinalRankingResponse.
+type FinalRankingResponse struct {
+	Ranking FinalRanking `json:"ranking"`
+}
+
+// FinalResponse defines model for FinalResponse.
+type FinalResponse struct {
+	Final Final `json:"final"`
+}
+
+// FinalStanding defines model for FinalStanding.
+type FinalStanding struct {
+	// FinalScore Final score; absent for players who did not reach the final and for finalists not scored yet.
+	//
+	// Example: 8
+	FinalScore *int `json:"finalScore,omitempty"`
+	Finalist   bool `json:"finalist"`
+
+	// Name Example: Player 1
+	Name string `json:"name"`
+
+	// PlayerID Example: 1
+	PlayerID int `json:"playerID"`
+
+	// PreliminaryRank Example: 1
+	PreliminaryRank int `json:"preliminaryRank"`
+
+	// PreliminaryScore Example: 42
+	PreliminaryScore int `json:"preliminaryScore"`
+
+	// Rank Example: 1
+	Rank int `json:"rank"`
+
+	// Seed Seed of the finalist; absent for players who did not reach the final.
+	//
+	// Example: 1
+	Seed *int `json:"seed,omitempty"`
+
+	// TeamID Team of the player; absent in individual games.
+	//
+	// Example: 1
+	TeamID *int `json:"teamID,omitempty"`
+}
+
+// FinalStatus Example: in_progress
+type FinalStatus string
+
+// FinalTable defines model for FinalTable.
+type FinalTable struct {
+	// Id Example: 1
+	Id int `json:"id"`
+
+	// Players Finalists of the table in seed order.
+	Players []FinalPlayer `json:"players"`
+
+	// TableNumber Example: 1
+	TableNumber int `json:"tableNumber"`
+}
+
+// FinalTableResponse defines model for FinalTableResponse.
+type FinalTableResponse struct {
+	Table FinalTable `json:"table"`
+}
+
+// Game defines model for Game.
+type Game struct {
+	// Draw Seed and algorithm version of the last table draw.
+	Draw *Draw `json:"draw,omitempty"`
+
+	// Id Example: 1
+	Id int `json:"id"`
+
+	// Mode team games draw teams of players and rank teams and players; individual games register players directly against the game and rank players only.
+	//
+	// Example: team
+	Mode GameMode `json:"mode"`
+
+	// Name Example: Game 1
+	Name string `json:"name"`
+
+	// NumberOfRounds Example: 2
+	NumberOfRounds int         `json:"numberOfRounds"`
+	Owners         []GameOwner `json:"owners"`
+
+	// PairingMode random draws all rounds at setup; swiss draws round 1 at setup and every further round from the current standings.
+	//
+	// Example: random
+	PairingMode PairingMode `json:"pairingMode"`
+
+	// Players Players registered directly against an individual game.
+	Players *[]Player    `json:"players,omitempty"`
+	Ranking Ranking      `json:"ranking"`
+	Rounds  *[]GameRound `json:"rounds,omitempty"`
+
+	// Seating How players are seated when they do not fill all tables.
+	Seating Seating `json:"seating"`
+
+	// Status Example: setup
+	Status GameStatus `json:"status"`
+
+	// TableLayout Seats of every table in a round, overriding the table size. Tables may differ in size.
+	//
+	// Example: [5,5,4]
+	TableLayout *TableLayout `json:"tableLayout,omitempty"`
+
+	// TableSize Example: 4
+	TableSize int `json:"tableSize"`
+
+	// TeamSize Example: 4
+	TeamSize int     `json:"teamSize"`
+	Teams    *[]Team `json:"teams,omitempty"`
+}
+
+// GameArchive Portable copy of a game. IDs are those of the exporting instance and only link the parts of the archive to each other; an import assigns fresh ones.
+type GameArchive struct {
+	Game ArchiveGame `json:"game"`
+
+	// Version Version of the archive format, currently 1.
+	//
+	// Example: 1
+	Version int `json:"version"`
+}
+
+// GameCloneRequest defines model for GameCloneRequest.
+type GameCloneRequest struct {
+	// ExcludeTeams IDs of the teams not to copy.
+	//
+	// Example: [3]
+	ExcludeTeams *[]int `json:"excludeTeams,omitempty"`
+
+	// Name Name of the new game, the name of the cloned game if omitted.
+	//
+	// Example: Spring Cup 2026
+	Name *string `json:"name,omitempty"`
+}
+
+// GameCreateRequest defines model for GameCreateRequest.
+type GameCreateRequest struct {
+	// Mode team games draw teams of players and rank teams and players; individual games register players directly against the game and rank players only.
+	//
+	// Example: team
+	Mode           *GameMode `json:"mode,omitempty"`
+	Name           string    `json:"name"`
+	NumberOfRounds int       `json:"numberOfRounds"`
+
+	// PairingMode random draws all rounds at setup; swiss draws round 1 at setup and every further round from the current standings.
+	//
+	// Example: random
+	PairingMode *PairingMode `json:"pairingMode,omitempty"`
+
+	// Seating How players are seated when they do not fill all tables.
+	Seating *Seating `json:"seating,omitempty"`
+
+	// TableLayout Seats of every table in a round, overriding the table size. Tables may differ in size.
+	//
+	// Example: [5,5,4]
+	TableLayout *TableLayout `json:"tableLayout,omitempty"`
+	TableSize   int          `json:"tableSize"`
+
+	// TeamSize Ignored for individual games, which always have a team size of 1.
+	TeamSize int `json:"teamSize"`
+}
+
+// GameMode team games draw teams of players and rank teams and players; individual games register players directly against the game and rank players only.
+//
+// Example: team
+type GameMode string
+
+// GameOwner defines model for GameOwner.
+type GameOwner struct {
+	// Email Resolved live from Firebase; absent if the user cannot be resolved.
+	//
+	// Example: owner@example.org
+	Email *string `json:"email,omitempty"`
+
+	// GameID Example: 1
+	GameID int `json:"gameID"`
+
+	// OwnerSub Example: sub-1
+	OwnerSub string `json:"ownerSub"`
+}
+
+// GameResponse defines model for GameResponse.
+type GameResponse struct {
+	Game Game `json:"game"`
+}
+
+// GameRound Round skeleton returned as part of game structure. Tables and scores are loaded lazily via the per-round tables endpoints, not embedded here.
+type GameRound struct {
+	// Byes IDs of the players sitting out the round.
+	Byes        *[]int `json:"byes,omitempty"`
+	GameID      int    `json:"gameID"`
+	Id          int    `json:"id"`
+	RoundNumber int    `json:"roundNumber"`
+
+	// StageID Stage the round belongs to in games played in stages. Rounds are numbered across all stages.
+	StageID *int `json:"stageID,omitempty"`
+
+	// Status Example: in_progress
+	Status RoundStatus `json:"status"`
+}
+
+// GameStatus Example: setup
+type GameStatus string
+
+// GameUpdateRequest defines model for GameUpdateRequest.
+type GameUpdateRequest struct {
+	Name           string `json:"name"`
+	NumberOfRounds int    `json:"numberOfRounds"`
+
+	// PairingMode random draws all rounds at setup; swiss draws round 1 at setup and every further round from the current standings.
+	//
+	// Example: random
+	PairingMode *PairingMode `json:"pairingMode,omitempty"`
+	Ranking     *Ranking     `json:"ranking,omitempty"`
+
+	// Seating How players are seated when they do not fill all tables.
+	Seating *Seating `json:"seating,omitempty"`
+
+	// Status Example: setup
+	Status GameStatus `json:"status"`
+
+	// TableLayout Seats of every table in a round, overriding the table size. Tables may differ in size.
+	//
+	// Example: [5,5,4]
+	TableLayout *TableLayout `json:"tableLayout,omitempty"`
+	TableSize   int          `json:"tableSize"`
+	TeamSize    int          `json:"teamSize"`
+}
+
+// GamesResponse defines model for GamesResponse.
+type GamesResponse struct {
+	Games []Game `json:"games"`
+}
+
+// ImportErrorResponse defines model for ImportErrorResponse.
+type ImportErrorResponse struct {
+	// Error Example: Invalid lines
+	Error string            `json:"error"`
+	Lines []ImportLineError `json:"lines"`
+}
+
+// ImportLineError defines model for ImportLineError.
+type ImportLineError struct {
+	// Line Example: 3
+	Line int `json:"line"`
+
+	// Message Example: team "Team 1" has more than 4 players
+	Message string `json:"message"`
+}
+
+// PairingMode random draws all rounds at setup; swiss draws round 1 at setup and every further round from the current standings.
+//
+// Example: random
+type PairingMode string
+
+// Player defines model for Player.
+type Player struct {
+	// Id Example: 1
+	Id int `json:"id"`
+
+	// Name Example: Player 1
+	Name string `json:"name"`
+
+	// Substitute Set for players added as a substitute; they are not part of the table draw.
+	Substitute *bool `json:"substitute,omitempty"`
+
+	// TeamID Team of the player; absent for players of individual games.
+	//
+	// Example: 1
+	TeamID *int `json:"teamID,omitempty"`
+}
+
+// PlayerStanding defines model for PlayerStanding.
+type PlayerStanding struct {
+	// Byes Rounds sat out, credited with the bye score.
+	//
+	// Example: 0
+	Byes int `json:"byes"`
+
+	// Complete False if the player misses a score at a seated table.
+	Complete bool `json:"complete"`
+
+	// GapToLeader Points behind the leading player.
+	//
+	// Example: 0
+	GapToLeader int `json:"gapToLeader"`
+
+	// Name Example: Player 1
+	Name string `json:"name"`
+
+	// PlayerID Example: 1
+	PlayerID int `json:"playerID"`
+
+	// PreviousRank Rank after the previous completed round; absent if there is none.
+	//
+	// Example: 3
+	PreviousRank *int `json:"previousRank,omitempty"`
+
+	// Rank Competition rank; tied entries share a rank.
+	//
+	// Example: 1
+	Rank int `json:"rank"`
+
+	// RankChange Places gained since the previous round; negative if the player dropped.
+	//
+	// Example: 2
+	RankChange *int            `json:"rankChange,omitempty"`
+	Rounds     []RoundSubtotal `json:"rounds"`
+
+	// ShortTables Rounds played at a table with fewer seats than the table size.
+	//
+	// Example: 0
+	ShortTables int `json:"shortTables"`
+
+	// SubstitutedIn Rounds played as a substitute for a teammate.
+	//
+	// Example: 0
+	SubstitutedIn int `json:"substitutedIn"`
+
+	// SubstitutedOut Rounds in which the player was replaced by a substitute.
+	//
+	// Example: 0
+	SubstitutedOut int `json:"substitutedOut"`
+
+	// TeamID Team of the player; absent in individual games.
+	//
+	// Example: 1
+	TeamID *int `json:"teamID,omitempty"`
+
+	// TotalScore Example: 12
+	TotalScore int `json:"totalScore"`
+}
+
+// PlayerTableSpread defines model for PlayerTableSpread.
+type PlayerTableSpread struct {
+	// DistinctTables Example: 2
+	DistinctTables int `json:"distinctTables"`
+
+	// PlayerID Example: 1
+	PlayerID int `json:"playerID"`
+
+	// Tables Table number per round in round order; 0 if the player was not seated.
+	Tables []int `json:"tables"`
+}
+
+// PlayersRequest defines model for PlayersRequest.
+type PlayersRequest struct {
+	Name string `json:"name"`
+}
+
+// PlayersResponse defines model for PlayersResponse.
+type PlayersResponse struct {
+	Player Player `json:"player"`
+}
+
+// PreviewRound defines model for PreviewRound.
+type PreviewRound struct {
+	// Byes Players sitting out the round.
+	Byes *[]int `json:"byes,omitempty"`
+
+	// RoundNumber Example: 1
+	RoundNumber int            `json:"roundNumber"`
+	Tables      []PreviewTable `json:"tables"`
+}
+
+// PreviewTable defines model for PreviewTable.
+type PreviewTable struct {
+	// Capacity Example: 4
+	Capacity int      `json:"capacity"`
+	Players  []Player `json:"players"`
+
+	// TableNumber Example: 1
+	TableNumber int `json:"tableNumber"`
+}
+
+// QualificationRule all opens the stage to every player; top_overall takes the best players of the previous stage; top_per_group the best players of every group of the previous stage.
+//
+// Example: top_overall
+type QualificationRule string
+
+// Ranking defines model for Ranking.
+type Ranking struct {
+	// CoinFlipSeed Seed of the coin_flip tie-breaker; generated on first use if omitted.
+	//
+	// Example: 1718000000
+	CoinFlipSeed *int64 `json:"coinFlipSeed,omitempty"`
+
+	// ScoreDirection Example: higher_wins
+	ScoreDirection ScoreDirection `json:"scoreDirection"`
+
+	// TieBreakers Applied in order to entries with the same total score.
+	TieBreakers []TieBreaker `json:"tieBreakers"`
+}
+
+// RoundResponse defines model for RoundResponse.
+type RoundResponse struct {
+	// Round Round skeleton returned as part of game structure. Tables and scores are loaded lazily via the per-round tables endpoints, not embedded here.
+	Round GameRound `json:"round"`
+}
+
+// RoundStatus Example: in_progress
+type RoundStatus string
+
+// RoundSubtotal defines model for RoundSubtotal.
+type RoundSubtotal struct {
+	// RoundNumber Example: 1
+	RoundNumber int `json:"roundNumber"`
+
+	// Score Example: 12
+	Score int `json:"score"`
+}
+
+// Score defines model for Score.
+type Score struct {
+	// Id Example: 100
+	Id int `json:"id"`
+
+	// PlayerID Example: 1
+	PlayerID int `json:"playerID"`
+
+	// Score Example: 6
+	Score int `json:"score"`
+
+	// TableID Example: 10
+	TableID int `json:"tableID"`
+}
+
+// ScoreDirection Example: higher_wins
+type ScoreDirection string
+
+// ScoreHistoryEntry defines model for ScoreHistoryEntry.
+type ScoreHistoryEntry struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Example: 1
+	Id int `json:"id"`
+
+	// NewScore Example: 5
+	NewScore int `json:"newScore"`
+
+	// OldScore Score before the write; absent for the first score of the player at the table.
+	//
+	// Example: 3
+	OldScore *int `json:"oldScore,omitempty"`
+
+	// OwnerSub Example: sub-1
+	OwnerSub string `json:"ownerSub"`
+
+	// PlayerID Absent once the player has been deleted.
+	//
+	// Example: 1
+	PlayerID *int `json:"playerID,omitempty"`
+
+	// PlayerName Name of the player at the time of the write.
+	//
+	// Example: Player 1
+	PlayerName string `json:"playerName"`
+
+	// Reason Example: Typo in round 2
+	Reason *string `json:"reason,omitempty"`
+}
+
+// ScoreHistoryResponse defines model for ScoreHistoryResponse.
+type ScoreHistoryResponse struct {
+	History []ScoreHistoryEntry `json:"history"`
+}
+
+// ScoresRequest defines model for ScoresRequest.
+type ScoresRequest struct {
+	// Reason Why the scores are changed; required once the round is completed.
+	//
+	// Example: Typo in round 2
+	Reason *string `json:"reason,omitempty"`
+	Scores []struct {
+		PlayerID int `json:"playerID"`
+		Score    int `json:"score"`
+	} `json:"scores"`
+}
+
+// SeatMoveRequest defines model for SeatMoveRequest.
+type SeatMoveRequest struct {
+	// PlayerID Player to move.
+	//
+	// Example: 1
+	PlayerID int `json:"playerID"`
+
+	// TableNumber Table with a free seat the player moves to.
+	//
+	// Example: 3
+	TableNumber int `json:"tableNumber"`
+}
+
+// SeatOrderRequest defines model for SeatOrderRequest.
+type SeatOrderRequest struct {
+	// SeatOrder Every player of the table once, in seat order. The first player starts.
+	//
+	// Example: [5,9,13,1]
+	SeatOrder []int `json:"seatOrder"`
+}
+
+// SeatSwapRequest defines model for SeatSwapRequest.
+type SeatSwapRequest struct {
+	// OtherPlayerID Example: 6
+	OtherPlayerID int `json:"otherPlayerID"`
+
+	// PlayerID Example: 1
+	PlayerID int `json:"playerID"`
+}
+
+// Seating How players are seated when they do not fill all tables.
+type Seating struct {
+	// ByeScore Score credited to a player for a round sat out.
+	//
+	// Example: 0
+	ByeScore int `json:"byeScore"`
+
+	// Byes Allow players to sit out a round if short tables are not allowed or not enough.
+	Byes bool `json:"byes"`
+
+	// ShortTables Allow tables with one seat fewer than the table size.
+	ShortTables bool `json:"shortTables"`
+}
+
+// SetupAcceptRequest defines model for SetupAcceptRequest.
+type SetupAcceptRequest struct {
+	// Seed Seed of the accepted preview.
+	//
+	// Example: 1718000000
+	Seed int64 `json:"seed"`
+
+	// Token Token of the accepted preview.
+	//
+	// Example: 3f2a9c
+	Token string `json:"token"`
+}
+
+// SetupPreview defines model for SetupPreview.
+type SetupPreview struct {
+	// Algorithm Example: assign-rounds-v1
+	Algorithm string `json:"algorithm"`
+
+	// Quality How evenly the encounters of a draw are spread over all rounds.
+	Quality DrawQuality    `json:"quality"`
+	Rounds  []PreviewRound `json:"rounds"`
+
+	// Seed Example: 1718000000
+	Seed int64 `json:"seed"`
+
+	// Token Identifies the drawn tables; required to accept the preview.
+	//
+	// Example: 3f2a9c
+	Token string `json:"token"`
+}
+
+// SetupPreviewResponse defines model for SetupPreviewResponse.
+type SetupPreviewResponse struct {
+	Preview SetupPreview `json:"preview"`
+}
+
+// SetupReport defines model for SetupReport.
+type SetupReport struct {
+	// MaxPlayerEncounters Highest number of times any two players sat at the same table.
+	//
+	// Example: 1
+	MaxPlayerEncounters int `json:"maxPlayerEncounters"`
+
+	// MaxTeamEncounters Highest number of times players of any two teams sat at the same table.
+	//
+	// Example: 4
+	MaxTeamEncounters int `json:"maxTeamEncounters"`
+
+	// PlayerEncounters counts[i][j] is how often ids[i] and ids[j] sat at the same table.
+	PlayerEncounters EncounterMatrix     `json:"playerEncounters"`
+	TableSpread      []PlayerTableSpread `json:"tableSpread"`
+
+	// TeamEncounters counts[i][j] is how often ids[i] and ids[j] sat at the same table.
+	TeamEncounters EncounterMatrix `json:"teamEncounters"`
+	Violations     []Violation     `json:"violations"`
+}
+
+// SetupReportResponse defines model for SetupReportResponse.
+type SetupReportResponse struct {
+	Report SetupReport `json:"report"`
+}
+
+// SetupRequest defines model for SetupRequest.
+type SetupRequest struct {
+	// Seed Seed of the table draw; generated if omitted.
+	//
+	// Example: 1718000000
+	Seed *int64 `json:"seed,omitempty"`
+}
+
+// Share defines model for Share.
+type Share struct {
+	// AbbreviateNames Player names are shown as first name and initial of the last name.
+	AbbreviateNames bool      `json:"abbreviateNames"`
+	CreatedAt       time.Time `json:"createdAt"`
+
+	// Token Unguessable token unlocking the public view of the game.
+	//
+	// Example: XK4H2MZQ7RT5WB3NJ6PLC2VDEY
+	Token string `json:"token"`
+}
+
+// ShareRequest defines model for ShareRequest.
+type ShareRequest struct {
+	// AbbreviateNames Show player names as first name and initial of the last name. Defaults to false.
+	AbbreviateNames *bool `json:"abbreviateNames,omitempty"`
+}
+
+// ShareResponse defines model for ShareResponse.
+type ShareResponse struct {
+	Share Share `json:"share"`
+}
+
+// Stage defines model for Stage.
+type Stage struct {
+	// CarryOver none starts every qualifier at zero; full carries the total score of the previous stage over.
+	//
+	// Example: none
+	CarryOver CarryOver `json:"carryOver"`
+
+	// Groups Number of groups drawn on separate tables.
+	//
+	// Example: 2
+	Groups int `json:"groups"`
+
+	// Id Example: 1
+	Id int `json:"id"`
+
+	// Name Example: Group stage
+	Name string `json:"name"`
+
+	// NumberOfRounds Example: 3
+	NumberOfRounds int `json:"numberOfRounds"`
+
+	// PairingMode random draws all rounds at setup; swiss draws round 1 at setup and every further round from the current standings.
+	//
+	// Example: random
+	PairingMode PairingMode `json:"pairingMode"`
+
+	// Players Players taking part, set once the stage has started.
+	Players *[]StagePlayer `json:"players,omitempty"`
+
+	// Position Place of the stage in the order of play, starting at 1.
+	//
+	// Example: 1
+	Position int `json:"position"`
+
+	// Qualification all opens the stage to every player; top_overall takes the best players of the previous stage; top_per_group the best players of every group of the previous stage.
+	//
+	// Example: top_overall
+	Qualification QualificationRule `json:"qualification"`
+
+	// Qualifiers Number of players qualifying overall or per group; zero for a stage open to all players.
+	//
+	// Example: 0
+	Qualifiers int `json:"qualifiers"`
+
+	// Status Example: pending
+	Status StageStatus `json:"status"`
+}
+
+// StagePlayer defines model for StagePlayer.
+type StagePlayer struct {
+	// CarriedScore Score carried over from the previous stage.
+	//
+	// Example: 0
+	CarriedScore int `json:"carriedScore"`
+
+	// Group Group the player is drawn in, starting at 1.
+	//
+	// Example: 1
+	Group int `json:"group"`
+
+	// PlayerID Example: 1
+	PlayerID int `json:"playerID"`
+}
+
+// StageRequest defines model for StageRequest.
+type StageRequest struct {
+	// CarryOver none starts every qualifier at zero; full carries the total score of the previous stage over.
+	//
+	// Example: none
+	CarryOver *CarryOver `json:"carryOver,omitempty"`
+
+	// Groups Number of groups drawn on separate tables; defaults to 1.
+	//
+	// Example: 2
+	Groups *int `json:"groups,omitempty"`
+
+	// Name Example: Group stage
+	Name string `json:"name"`
+
+	// NumberOfRounds Example: 3
+	NumberOfRounds int `json:"numberOfRounds"`
+
+	// PairingMode random draws all rounds at setup; swiss draws round 1 at setup and every further round from the current standings.
+	//
+	// Example: random
+	PairingMode *PairingMode `json:"pairingMode,omitempty"`
+
+	// Qualification all opens the stage to every player; top_overall takes the best players of the previous stage; top_per_group the best players of every group of the previous stage.
+	//
+	// Example: top_overall
+	Qualification QualificationRule `json:"qualification"`
+
+	// Qualifiers Number of players qualifying overall or per group, required for every stage but the first.
+	//
+	// Example: 4
+	Qualifiers *int `json:"qualifiers,omitempty"`
+}
+
+// StageResponse defines model for StageResponse.
+type StageResponse struct {
+	Stage Stage `json:"stage"`
+}
+
+// StageStatus Example: pending
+type StageStatus string
+
+// StagesRequest defines model for StagesRequest.
+type StagesRequest struct {
+	Stages []StageRequest `json:"stages"`
+}
+
+// StagesResponse defines model for StagesResponse.
+type StagesResponse struct {
+	Stages []Stage `json:"stages"`
+}
+
+// Standings defines model for Standings.
+type Standings struct {
+	// AfterRound Number of the last counted round; 0 if no round has been completed yet.
+	//
+	// Example: 3
+	AfterRound int `json:"afterRound"`
+
+	// Complete False if any seated player misses a score in the counted rounds.
+	Complete bool `json:"complete"`
+
+	// GameID Example: 1
+	GameID  int              `json:"gameID"`
+	Players []PlayerStanding `json:"players"`
+	Teams   []TeamStanding   `json:"teams"`
+}
+
+// StandingsResponse defines model for StandingsResponse.
+type StandingsResponse struct {
+	Standings Standings `json:"standings"`
+}
+
+// Substitution defines model for Substitution.
+type Substitution struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Example: 1
+	Id int `json:"id"`
+
+	// PlayerID Example: 1
+	PlayerID int `json:"playerID"`
+
+	// RoundNumber Example: 2
+	RoundNumber int `json:"roundNumber"`
+
+	// SubstituteID Example: 5
+	SubstituteID int `json:"substituteID"`
+
+	// TableNumber Example: 3
+	TableNumber int `json:"tableNumber"`
+}
+
+// SubstitutionRequest defines model for SubstitutionRequest.
+type SubstitutionRequest struct {
+	// PlayerID Seated player to replace.
+	//
+	// Example: 1
+	PlayerID int `json:"playerID"`
+
+	// Scope round replaces the player in the given round only; remaining also in every later round that is not completed. Swiss games and stages only support round, as their further rounds are drawn from the team rosters.
+	//
+	// Example: remaining
+	Scope SubstitutionScope `json:"scope"`
+
+	// SubstituteID Teammate taking the seat; mutually exclusive with substituteName.
+	//
+	// Example: 5
+	SubstituteID *int `json:"substituteID,omitempty"`
+
+	// SubstituteName Name of a new player of the team taking the seat; mutually exclusive with substituteID.
+	//
+	// Example: Player 5
+	SubstituteName *string `json:"substituteName,omitempty"`
+}
+
+// SubstitutionScope round replaces the player in the given round only; remaining also in every later round that is not completed. Swiss games and stages only support round, as their further rounds are drawn from the team rosters.
+//
+// Example: remaining
+type SubstitutionScope string
+
+// SubstitutionsResponse defines model for SubstitutionsResponse.
+type SubstitutionsResponse struct {
+	Substitutions []Substitution `json:"substitutions"`
+}
+
+// Table defines model for Table.
+type Table struct {
+	// Capacity Number of seats at the table.
+	//
+	// Example: 4
+	Capacity int `json:"capacity"`
+
+	// Id Example: 10
+	Id int `json:"id"`
+
+	// Players Seated players in seat order.
+	Players *[]Player `json:"players,omitempty"`
+
+	// RoundID Example: 5
+	RoundID int      `json:"roundID"`
+	Scores  *[]Score `json:"scores,omitempty"`
+
+	// SeatOrder Player IDs in seat order. The first player starts.
+	//
+	// Example: [5,9,13,1]
+	SeatOrder *[]int `json:"seatOrder,omitempty"`
+
+	// StageID Stage the table belongs to in games played in stages.
+	//
+	// Example: 1
+	StageID *int `json:"stageID,omitempty"`
+
+	// TableNumber Example: 1
+	TableNumber int `json:"tableNumber"`
+}
+
+// TableLayout Seats of every table in a round, overriding the table size. Tables may differ in size.
+//
+// Example: [5,5,4]
+type TableLayout = []int
+
+// TableResponse defines model for TableResponse.
+type TableResponse struct {
+	Table Table `json:"table"`
+}
+
+// TablesResponse defines model for TablesResponse.
+type TablesResponse struct {
+	Tables []Table `json:"tables"`
+}
+
+// Team defines model for Team.
+type Team struct {
 	// GameID Example: 1
 	GameID int `json:"gameID"`
 
@@ -230,113 +1399,1314 @@ type Team struct {
 	Players *[]Player `json:"players,omitempty"`
 }
 
-// TeamResponse defines model for TeamResponse.
-type TeamResponse struct {
-	Team Team `json:"team"`
+// TeamResponse defines model for TeamResponse.
+type TeamResponse struct {
+	Team Team `json:"team"`
+}
+
+// TeamStanding defines model for TeamStanding.
+type TeamStanding struct {
+	// Byes Rounds sat out by players of the team, credited with the bye score.
+	//
+	// Example: 0
+	Byes int `json:"byes"`
+
+	// Complete False if a player of the team misses a score at a seated table.
+	Complete bool `json:"complete"`
+
+	// GapToLeader Points behind the leading team.
+	//
+	// Example: 0
+	GapToLeader int `json:"gapToLeader"`
+
+	// Name Example: Team A
+	Name string `json:"name"`
+
+	// PreviousRank Rank after the previous completed round; absent if there is none.
+	//
+	// Example: 3
+	PreviousRank *int `json:"previousRank,omitempty"`
+
+	// Rank Competition rank; tied entries share a rank.
+	//
+	// Example: 1
+	Rank int `json:"rank"`
+
+	// RankChange Places gained since the previous round; negative if the team dropped.
+	//
+	// Example: 2
+	RankChange *int            `json:"rankChange,omitempty"`
+	Rounds     []RoundSubtotal `json:"rounds"`
+
+	// ShortTables Rounds played by players of the team at a table with fewer seats than the table size.
+	//
+	// Example: 0
+	ShortTables int `json:"shortTables"`
+
+	// TeamID Example: 1
+	TeamID int `json:"teamID"`
+
+	// TotalScore Sum of the scores of all team players.
+	//
+	// Example: 42
+	TotalScore int `json:"totalScore"`
+}
+
+// TeamsImportResponse defines model for TeamsImportResponse.
+type TeamsImportResponse struct {
+	Teams []Team `json:"teams"`
+}
+
+// TeamsRequest defines model for TeamsRequest.
+type TeamsRequest struct {
+	Name    string            `json:"name"`
+	Players *[]PlayersRequest `json:"players,omitempty"`
+}
+
+// TieBreaker defines model for TieBreaker.
+type TieBreaker string
+
+// Violation defines model for Violation.
+type Violation struct {
+	PlayerIDs []int `json:"playerIDs"`
+
+	// RoundNumber Example: 1
+	RoundNumber int `json:"roundNumber"`
+
+	// TableNumber Example: 1
+	TableNumber int           `json:"tableNumber"`
+	Type        ViolationType `json:"type"`
+}
+
+// ViolationType defines model for ViolationType.
+type ViolationType string
+
+// GetStandingsParams defines parameters for GetStandings.
+type GetStandingsParams struct {
+	// AfterRound Only count rounds 1..N and compare against the ranking after the last completed round before N. Defaults to the last completed round.
+	AfterRound *int `form:"afterRound,omitempty" json:"afterRound,omitempty"`
+}
+
+// ImportTeamsParams defines parameters for ImportTeams.
+type ImportTeamsParams struct {
+	// Delimiter Column delimiter, a single character. Defaults to a comma.
+	Delimiter *string `form:"delimiter,omitempty" json:"delimiter,omitempty"`
+
+	// Header Skip the first line holding the column names. Defaults to false.
+	Header *bool `form:"header,omitempty" json:"header,omitempty"`
+}
+
+// CreateGameJSONRequestBody defines body for CreateGame for application/json ContentType.
+type CreateGameJSONRequestBody = GameCreateRequest
+
+// ImportGameJSONRequestBody defines body for ImportGame for application/json ContentType.
+type ImportGameJSONRequestBody = GameArchive
+
+// UpdateGameJSONRequestBody defines body for UpdateGame for application/json ContentType.
+type UpdateGameJSONRequestBody = GameUpdateRequest
+
+// CloneGameJSONRequestBody defines body for CloneGame for application/json ContentType.
+type CloneGameJSONRequestBody = GameCloneRequest
+
+// CreateFinalJSONRequestBody defines body for CreateFinal for application/json ContentType.
+type CreateFinalJSONRequestBody = FinalCreateRequest
+
+// UpdateFinalScoresJSONRequestBody defines body for UpdateFinalScores for application/json ContentType.
+type UpdateFinalScoresJSONRequestBody = ScoresRequest
+
+// AddOwnerJSONRequestBody defines body for AddOwner for application/json ContentType.
+type AddOwnerJSONRequestBody = AddOwnerRequest
+
+// CreateGamePlayerJSONRequestBody defines body for CreateGamePlayer for application/json ContentType.
+type CreateGamePlayerJSONRequestBody = PlayersRequest
+
+// UpdateGamePlayerJSONRequestBody defines body for UpdateGamePlayer for application/json ContentType.
+type UpdateGamePlayerJSONRequestBody = PlayersRequest
+
+// ReassignRoundJSONRequestBody defines body for ReassignRound for application/json ContentType.
+type ReassignRoundJSONRequestBody = SetupRequest
+
+// MovePlayerJSONRequestBody defines body for MovePlayer for application/json ContentType.
+type MovePlayerJSONRequestBody = SeatMoveRequest
+
+// SwapSeatsJSONRequestBody defines body for SwapSeats for application/json ContentType.
+type SwapSeatsJSONRequestBody = SeatSwapRequest
+
+// SubstitutePlayerJSONRequestBody defines body for SubstitutePlayer for application/json ContentType.
+type SubstitutePlayerJSONRequestBody = SubstitutionRequest
+
+// UpdateScoresJSONRequestBody defines body for UpdateScores for application/json ContentType.
+type UpdateScoresJSONRequestBody = ScoresRequest
+
+// UpdateSeatOrderJSONRequestBody defines body for UpdateSeatOrder for application/json ContentType.
+type UpdateSeatOrderJSONRequestBody = SeatOrderRequest
+
+// SetupGameJSONRequestBody defines body for SetupGame for application/json ContentType.
+type SetupGameJSONRequestBody = SetupRequest
+
+// AcceptSetupJSONRequestBody defines body for AcceptSetup for application/json ContentType.
+type AcceptSetupJSONRequestBody = SetupAcceptRequest
+
+// PreviewSetupJSONRequestBody defines body for PreviewSetup for application/json ContentType.
+type PreviewSetupJSONRequestBody = SetupRequest
+
+// CreateShareJSONRequestBody defines body for CreateShare for application/json ContentType.
+type CreateShareJSONRequestBody = ShareRequest
+
+// UpdateStagesJSONRequestBody defines body for UpdateStages for application/json ContentType.
+type UpdateStagesJSONRequestBody = StagesRequest
+
+// AdvanceStageJSONRequestBody defines body for AdvanceStage for application/json ContentType.
+type AdvanceStageJSONRequestBody = SetupRequest
+
+// CreateTeamJSONRequestBody defines body for CreateTeam for application/json ContentType.
+type CreateTeamJSONRequestBody = TeamsRequest
+
+// UpdateTeamJSONRequestBody defines body for UpdateTeam for application/json ContentType.
+type UpdateTeamJSONRequestBody = TeamsRequest
+
+// CreatePlayerJSONRequestBody defines body for CreatePlayer for application/json ContentType.
+type CreatePlayerJSONRequestBody = PlayersRequest
+
+// UpdatePlayerJSONRequestBody defines body for UpdatePlayer for application/json ContentType.
+type UpdatePlayerJSONRequestBody = PlayersRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// GetGames List games owned by the caller
+	// (GET /games)
+	GetGames(w http.ResponseWriter, r *http.Request)
+	// CreateGame Create a new game
+	// (POST /games)
+	CreateGame(w http.ResponseWriter, r *http.Request)
+	// ImportGame Import a game from an archive
+	// (POST /games/import)
+	ImportGame(w http.ResponseWriter, r *http.Request)
+	// DeleteGame Delete an existing game
+	// (DELETE /games/{gameID})
+	DeleteGame(w http.ResponseWriter, r *http.Request, gameID int)
+	// GetGame Get game by ID
+	// (GET /games/{gameID})
+	GetGame(w http.ResponseWriter, r *http.Request, gameID int)
+	// UpdateGame Update an existing game
+	// (PUT /games/{gameID})
+	UpdateGame(w http.ResponseWriter, r *http.Request, gameID int)
+	// CloneGame Clone a game
+	// (POST /games/{gameID}/clone)
+	CloneGame(w http.ResponseWriter, r *http.Request, gameID int)
+	// ExportGame Export a game as an archive
+	// (GET /games/{gameID}/export)
+	ExportGame(w http.ResponseWriter, r *http.Request, gameID int)
+	// GetFinal Get the final tables of a game
+	// (GET /games/{gameID}/finals)
+	GetFinal(w http.ResponseWriter, r *http.Request, gameID int)
+	// CreateFinal Seed the best players into final tables
+	// (POST /games/{gameID}/finals)
+	CreateFinal(w http.ResponseWriter, r *http.Request, gameID int)
+	// CloseFinal Close the final
+	// (POST /games/{gameID}/finals/close)
+	CloseFinal(w http.ResponseWriter, r *http.Request, gameID int)
+	// GetFinalRanking Final ranking of finalists and all other players
+	// (GET /games/{gameID}/finals/ranking)
+	GetFinalRanking(w http.ResponseWriter, r *http.Request, gameID int)
+	// UpdateFinalScores Update the final scores of a final table
+	// (PUT /games/{gameID}/finals/tables/{tableNumber}/scores)
+	UpdateFinalScores(w http.ResponseWriter, r *http.Request, gameID int, tableNumber int)
+	// AddOwner Add an owner to a game by email
+	// (POST /games/{gameID}/owners)
+	AddOwner(w http.ResponseWriter, r *http.Request, gameID int)
+	// RemoveOwner Remove an owner from a game
+	// (DELETE /games/{gameID}/owners/{ownerSub})
+	RemoveOwner(w http.ResponseWriter, r *http.Request, gameID int, ownerSub string)
+	// CreateGamePlayer Register a player in an individual game
+	// (POST /games/{gameID}/players)
+	CreateGamePlayer(w http.ResponseWriter, r *http.Request, gameID int)
+	// DeleteGamePlayer Delete a player of an individual game
+	// (DELETE /games/{gameID}/players/{playerID})
+	DeleteGamePlayer(w http.ResponseWriter, r *http.Request, gameID int, playerID int)
+	// UpdateGamePlayer Update a player of an individual game
+	// (PUT /games/{gameID}/players/{playerID})
+	UpdateGamePlayer(w http.ResponseWriter, r *http.Request, gameID int, playerID int)
+	// ExportResults Export the results as a spreadsheet
+	// (GET /games/{gameID}/results)
+	ExportResults(w http.ResponseWriter, r *http.Request, gameID int)
+	// NextRound Draw the next round of a swiss game from the current standings
+	// (POST /games/{gameID}/rounds/next)
+	NextRound(w http.ResponseWriter, r *http.Request, gameID int)
+	// CloseRound Close a round
+	// (POST /games/{gameID}/rounds/{roundNumber}/close)
+	CloseRound(w http.ResponseWriter, r *http.Request, gameID int, roundNumber int)
+	// ReassignRound Draw the tables of a single round again
+	// (POST /games/{gameID}/rounds/{roundNumber}/reassign)
+	ReassignRound(w http.ResponseWriter, r *http.Request, gameID int, roundNumber int)
+	// GetScoreSheets Print the score sheets of a round
+	// (GET /games/{gameID}/rounds/{roundNumber}/score-sheets)
+	GetScoreSheets(w http.ResponseWriter, r *http.Request, gameID int, roundNumber int)
+	// GetSeatingPlan Print the seating plan of a round
+	// (GET /games/{gameID}/rounds/{roundNumber}/seating-plan)
+	GetSeatingPlan(w http.ResponseWriter, r *http.Request, gameID int, roundNumber int)
+	// MovePlayer Move a player to a free seat at another table
+	// (POST /games/{gameID}/rounds/{roundNumber}/seats/move)
+	MovePlayer(w http.ResponseWriter, r *http.Request, gameID int, roundNumber int)
+	// SwapSeats Swap two players between tables of a round
+	// (POST /games/{gameID}/rounds/{roundNumber}/seats/swap)
+	SwapSeats(w http.ResponseWriter, r *http.Request, gameID int, roundNumber int)
+	// StartRound Start a round
+	// (POST /games/{gameID}/rounds/{roundNumber}/start)
+	StartRound(w http.ResponseWriter, r *http.Request, gameID int, roundNumber int)
+	// SubstitutePlayer Replace a seated player by a substitute
+	// (POST /games/{gameID}/rounds/{roundNumber}/substitutions)
+	SubstitutePlayer(w http.ResponseWriter, r *http.Request, gameID int, roundNumber int)
+	// GetTables List tables for a round
+	// (GET /games/{gameID}/rounds/{roundNumber}/tables)
+	GetTables(w http.ResponseWriter, r *http.Request, gameID int, roundNumber int)
+	// GetTable Get a table by number in round
+	// (GET /games/{gameID}/rounds/{roundNumber}/tables/{tableNumber})
+	GetTable(w http.ResponseWriter, r *http.Request, gameID int, roundNumber int, tableNumber int)
+	// UpdateScores Update scores for a table
+	// (PUT /games/{gameID}/rounds/{roundNumber}/tables/{tableNumber}/scores)
+	UpdateScores(w http.ResponseWriter, r *http.Request, gameID int, roundNumber int, tableNumber int)
+	// GetScoreHistory List every score write of a table
+	// (GET /games/{gameID}/rounds/{roundNumber}/tables/{tableNumber}/scores/history)
+	GetScoreHistory(w http.ResponseWriter, r *http.Request, gameID int, roundNumber int, tableNumber int)
+	// UpdateSeatOrder Override the seat order of a table
+	// (PUT /games/{gameID}/rounds/{roundNumber}/tables/{tableNumber}/seats)
+	UpdateSeatOrder(w http.ResponseWriter, r *http.Request, gameID int, roundNumber int, tableNumber int)
+	// SetupGame Setup game and assign tables for all rounds
+	// (POST /games/{gameID}/setup)
+	SetupGame(w http.ResponseWriter, r *http.Request, gameID int)
+	// AcceptSetup Assign the tables of a previewed draw
+	// (POST /games/{gameID}/setup/accept)
+	AcceptSetup(w http.ResponseWriter, r *http.Request, gameID int)
+	// PreviewSetup Preview the table draw of the game setup without assigning any tables
+	// (POST /games/{gameID}/setup/preview)
+	PreviewSetup(w http.ResponseWriter, r *http.Request, gameID int)
+	// GetSetupReport Analyse the assigned tables of all rounds
+	// (GET /games/{gameID}/setup/report)
+	GetSetupReport(w http.ResponseWriter, r *http.Request, gameID int)
+	// VerifySetup Re-run the table draw from the recorded seed and compare it with the assigned tables
+	// (GET /games/{gameID}/setup/verify)
+	VerifySetup(w http.ResponseWriter, r *http.Request, gameID int)
+	// RevokeShare Revoke the share link of a game
+	// (DELETE /games/{gameID}/share)
+	RevokeShare(w http.ResponseWriter, r *http.Request, gameID int)
+	// GetShare Get the share link of a game
+	// (GET /games/{gameID}/share)
+	GetShare(w http.ResponseWriter, r *http.Request, gameID int)
+	// CreateShare Share a game read-only
+	// (POST /games/{gameID}/share)
+	CreateShare(w http.ResponseWriter, r *http.Request, gameID int)
+	// GetStages Get the stages of a game
+	// (GET /games/{gameID}/stages)
+	GetStages(w http.ResponseWriter, r *http.Request, gameID int)
+	// UpdateStages Configure the stages of a game
+	// (PUT /games/{gameID}/stages)
+	UpdateStages(w http.ResponseWriter, r *http.Request, gameID int)
+	// AdvanceStage Advance to the next stage
+	// (POST /games/{gameID}/stages/advance)
+	AdvanceStage(w http.ResponseWriter, r *http.Request, gameID int)
+	// GetStageStandings Standings of a stage
+	// (GET /games/{gameID}/stages/{position}/standings)
+	GetStageStandings(w http.ResponseWriter, r *http.Request, gameID int, position int)
+	// GetStandings Ranked teams and players computed from the entered scores
+	// (GET /games/{gameID}/standings)
+	GetStandings(w http.ResponseWriter, r *http.Request, gameID int, params GetStandingsParams)
+	// GetSubstitutions List the substitutions of a game
+	// (GET /games/{gameID}/substitutions)
+	GetSubstitutions(w http.ResponseWriter, r *http.Request, gameID int)
+	// GetGameTables List all tables for a game across rounds
+	// (GET /games/{gameID}/tables)
+	GetGameTables(w http.ResponseWriter, r *http.Request, gameID int)
+	// CreateTeam Create a team
+	// (POST /games/{gameID}/teams)
+	CreateTeam(w http.ResponseWriter, r *http.Request, gameID int)
+	// ImportTeams Import teams and players from CSV
+	// (POST /games/{gameID}/teams/import)
+	ImportTeams(w http.ResponseWriter, r *http.Request, gameID int, params ImportTeamsParams)
+	// DeleteTeam Delete a team
+	// (DELETE /games/{gameID}/teams/{teamID})
+	DeleteTeam(w http.ResponseWriter, r *http.Request, gameID int, teamID int)
+	// UpdateTeam Update a team
+	// (PUT /games/{gameID}/teams/{teamID})
+	UpdateTeam(w http.ResponseWriter, r *http.Request, gameID int, teamID int)
+	// CreatePlayer Create player in a team
+	// (POST /games/{gameID}/teams/{teamID}/players)
+	CreatePlayer(w http.ResponseWriter, r *http.Request, gameID int, teamID int)
+	// DeletePlayer Delete player
+	// (DELETE /games/{gameID}/teams/{teamID}/players/{playerID})
+	DeletePlayer(w http.ResponseWriter, r *http.Request, gameID int, teamID int, playerID int)
+	// UpdatePlayer Update player
+	// (PUT /games/{gameID}/teams/{teamID}/players/{playerID})
+	UpdatePlayer(w http.ResponseWriter, r *http.Request, gameID int, teamID int, playerID int)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetGames operation middleware
+func (siw *ServerInterfaceWrapper) GetGames(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGames(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateGame operation middleware
+func (siw *ServerInterfaceWrapper) CreateGame(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateGame(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportGame operation middleware
+func (siw *ServerInterfaceWrapper) ImportGame(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportGame(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteGame operation middleware
+func (siw *ServerInterfaceWrapper) DeleteGame(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteGame(w, r, gameID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetGame operation middleware
+func (siw *ServerInterfaceWrapper) GetGame(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGame(w, r, gameID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateGame operation middleware
+func (siw *ServerInterfaceWrapper) UpdateGame(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateGame(w, r, gameID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CloneGame operation middleware
+func (siw *ServerInterfaceWrapper) CloneGame(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CloneGame(w, r, gameID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportGame operation middleware
+func (siw *ServerInterfaceWrapper) ExportGame(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportGame(w, r, gameID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFinal operation middleware
+func (siw *ServerInterfaceWrapper) GetFinal(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFinal(w, r, gameID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateFinal operation middleware
+func (siw *ServerInterfaceWrapper) CreateFinal(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateFinal(w, r, gameID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CloseFinal operation middleware
+func (siw *ServerInterfaceWrapper) CloseFinal(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CloseFinal(w, r, gameID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFinalRanking operation middleware
+func (siw *ServerInterfaceWrapper) GetFinalRanking(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFinalRanking(w, r, gameID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateFinalScores operation middleware
+func (siw *ServerInterfaceWrapper) UpdateFinalScores(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tableNumber" -------------
+	var tableNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableNumber", r.PathValue("tableNumber"), &tableNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableNumber", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateFinalScores(w, r, gameID, tableNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddOwner operation middleware
+func (siw *ServerInterfaceWrapper) AddOwner(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddOwner(w, r, gameID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveOwner operation middleware
+func (siw *ServerInterfaceWrapper) RemoveOwner(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "ownerSub" -------------
+	var ownerSub string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ownerSub", r.PathValue("ownerSub"), &ownerSub, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ownerSub", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveOwner(w, r, gameID, ownerSub)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateGamePlayer operation middleware
+func (siw *ServerInterfaceWrapper) CreateGamePlayer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateGamePlayer(w, r, gameID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteGamePlayer operation middleware
+func (siw *ServerInterfaceWrapper) DeleteGamePlayer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "playerID" -------------
+	var playerID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "playerID", r.PathValue("playerID"), &playerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "playerID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteGamePlayer(w, r, gameID, playerID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateGamePlayer operation middleware
+func (siw *ServerInterfaceWrapper) UpdateGamePlayer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "playerID" -------------
+	var playerID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "playerID", r.PathValue("playerID"), &playerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "playerID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateGamePlayer(w, r, gameID, playerID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportResults operation middleware
+func (siw *ServerInterfaceWrapper) ExportResults(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportResults(w, r, gameID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// NextRound operation middleware
+func (siw *ServerInterfaceWrapper) NextRound(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.NextRound(w, r, gameID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CloseRound operation middleware
+func (siw *ServerInterfaceWrapper) CloseRound(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "roundNumber" -------------
+	var roundNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "roundNumber", r.PathValue("roundNumber"), &roundNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roundNumber", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CloseRound(w, r, gameID, roundNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReassignRound operation middleware
+func (siw *ServerInterfaceWrapper) ReassignRound(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "roundNumber" -------------
+	var roundNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "roundNumber", r.PathValue("roundNumber"), &roundNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roundNumber", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReassignRound(w, r, gameID, roundNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetScoreSheets operation middleware
+func (siw *ServerInterfaceWrapper) GetScoreSheets(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "roundNumber" -------------
+	var roundNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "roundNumber", r.PathValue("roundNumber"), &roundNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roundNumber", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetScoreSheets(w, r, gameID, roundNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSeatingPlan operation middleware
+func (siw *ServerInterfaceWrapper) GetSeatingPlan(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "roundNumber" -------------
+	var roundNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "roundNumber", r.PathValue("roundNumber"), &roundNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roundNumber", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSeatingPlan(w, r, gameID, roundNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MovePlayer operation middleware
+func (siw *ServerInterfaceWrapper) MovePlayer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "roundNumber" -------------
+	var roundNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "roundNumber", r.PathValue("roundNumber"), &roundNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roundNumber", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MovePlayer(w, r, gameID, roundNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SwapSeats operation middleware
+func (siw *ServerInterfaceWrapper) SwapSeats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "roundNumber" -------------
+	var roundNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "roundNumber", r.PathValue("roundNumber"), &roundNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roundNumber", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SwapSeats(w, r, gameID, roundNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartRound operation middleware
+func (siw *ServerInterfaceWrapper) StartRound(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "roundNumber" -------------
+	var roundNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "roundNumber", r.PathValue("roundNumber"), &roundNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roundNumber", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartRound(w, r, gameID, roundNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// TeamsRequest defines model for TeamsRequest.
-type TeamsRequest struct {
-	Name    string            `json:"name"`
-	Players *[]PlayersRequest `json:"players,omitempty"`
+// SubstitutePlayer operation middleware
+func (siw *ServerInterfaceWrapper) SubstitutePlayer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "roundNumber" -------------
+	var roundNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "roundNumber", r.PathValue("roundNumber"), &roundNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roundNumber", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubstitutePlayer(w, r, gameID, roundNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// CreateGameJSONRequestBody defines body for CreateGame for application/json ContentType.
-type CreateGameJSONRequestBody = GameCreateRequest
+// GetTables operation middleware
+func (siw *ServerInterfaceWrapper) GetTables(w http.ResponseWriter, r *http.Request) {
 
-// UpdateGameJSONRequestBody defines body for UpdateGame for application/json ContentType.
-type UpdateGameJSONRequestBody = GameUpdateRequest
+	var err error
+	_ = err
 
-// AddOwnerJSONRequestBody defines body for AddOwner for application/json ContentType.
-type AddOwnerJSONRequestBody = AddOwnerRequest
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
 
-// UpdateScoresJSONRequestBody defines body for UpdateScores for application/json ContentType.
-type UpdateScoresJSONRequestBody = ScoresRequest
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
 
-// CreateTeamJSONRequestBody defines body for CreateTeam for application/json ContentType.
-type CreateTeamJSONRequestBody = TeamsRequest
+	// ------------- Path parameter "roundNumber" -------------
+	var roundNumber int
 
-// UpdateTeamJSONRequestBody defines body for UpdateTeam for application/json ContentType.
-type UpdateTeamJSONRequestBody = TeamsRequest
+	err = runtime.BindStyledParameterWithOptions("simple", "roundNumber", r.PathValue("roundNumber"), &roundNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roundNumber", Err: err})
+		return
+	}
 
-// CreatePlayerJSONRequestBody defines body for CreatePlayer for application/json ContentType.
-type CreatePlayerJSONRequestBody = PlayersRequest
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTables(w, r, gameID, roundNumber)
+	}))
 
-// UpdatePlayerJSONRequestBody defines body for UpdatePlayer for application/json ContentType.
-type UpdatePlayerJSONRequestBody = PlayersRequest
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// GetGames List games owned by the caller
-	// (GET /games)
-	GetGames(w http.ResponseWriter, r *http.Request)
-	// CreateGame Create a new game
-	// (POST /games)
-	CreateGame(w http.ResponseWriter, r *http.Request)
-	// DeleteGame Delete an existing game
-	// (DELETE /games/{gameID})
-	DeleteGame(w http.ResponseWriter, r *http.Request, gameID int)
-	// GetGame Get game by ID
-	// (GET /games/{gameID})
-	GetGame(w http.ResponseWriter, r *http.Request, gameID int)
-	// UpdateGame Update an existing game
-	// (PUT /games/{gameID})
-	UpdateGame(w http.ResponseWriter, r *http.Request, gameID int)
-	// AddOwner Add an owner to a game by email
-	// (POST /games/{gameID}/owners)
-	AddOwner(w http.ResponseWriter, r *http.Request, gameID int)
-	// RemoveOwner Remove an owner from a game
-	// (DELETE /games/{gameID}/owners/{ownerSub})
-	RemoveOwner(w http.ResponseWriter, r *http.Request, gameID int, ownerSub string)
-	// GetTables List tables for a round
-	// (GET /games/{gameID}/rounds/{roundNumber}/tables)
-	GetTables(w http.ResponseWriter, r *http.Request, gameID int, roundNumber int)
-	// GetTable Get a table by number in round
-	// (GET /games/{gameID}/rounds/{roundNumber}/tables/{tableNumber})
-	GetTable(w http.ResponseWriter, r *http.Request, gameID int, roundNumber int, tableNumber int)
-	// UpdateScores Update scores for a table
-	// (PUT /games/{gameID}/rounds/{roundNumber}/tables/{tableNumber}/scores)
-	UpdateScores(w http.ResponseWriter, r *http.Request, gameID int, roundNumber int, tableNumber int)
-	// SetupGame Setup game and assign tables for all rounds
-	// (POST /games/{gameID}/setup)
-	SetupGame(w http.ResponseWriter, r *http.Request, gameID int)
-	// GetGameTables List all tables for a game across rounds
-	// (GET /games/{gameID}/tables)
-	GetGameTables(w http.ResponseWriter, r *http.Request, gameID int)
-	// CreateTeam Create a team
-	// (POST /games/{gameID}/teams)
-	CreateTeam(w http.ResponseWriter, r *http.Request, gameID int)
-	// DeleteTeam Delete a team
-	// (DELETE /games/{gameID}/teams/{teamID})
-	DeleteTeam(w http.ResponseWriter, r *http.Request, gameID int, teamID int)
-	// UpdateTeam Update a team
-	// (PUT /games/{gameID}/teams/{teamID})
-	UpdateTeam(w http.ResponseWriter, r *http.Request, gameID int, teamID int)
-	// CreatePlayer Create player in a team
-	// (POST /games/{gameID}/teams/{teamID}/players)
-	CreatePlayer(w http.ResponseWriter, r *http.Request, gameID int, teamID int)
-	// DeletePlayer Delete player
-	// (DELETE /games/{gameID}/teams/{teamID}/players/{playerID})
-	DeletePlayer(w http.ResponseWriter, r *http.Request, gameID int, teamID int, playerID int)
-	// UpdatePlayer Update player
-	// (PUT /games/{gameID}/teams/{teamID}/players/{playerID})
-	UpdatePlayer(w http.ResponseWriter, r *http.Request, gameID int, teamID int, playerID int)
+	handler.ServeHTTP(w, r)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// GetTable operation middleware
+func (siw *ServerInterfaceWrapper) GetTable(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "roundNumber" -------------
+	var roundNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "roundNumber", r.PathValue("roundNumber"), &roundNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roundNumber", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tableNumber" -------------
+	var tableNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableNumber", r.PathValue("tableNumber"), &tableNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableNumber", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTable(w, r, gameID, roundNumber, tableNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateScores operation middleware
+func (siw *ServerInterfaceWrapper) UpdateScores(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "roundNumber" -------------
+	var roundNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "roundNumber", r.PathValue("roundNumber"), &roundNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roundNumber", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tableNumber" -------------
+	var tableNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableNumber", r.PathValue("tableNumber"), &tableNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableNumber", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateScores(w, r, gameID, roundNumber, tableNumber)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// GetScoreHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetScoreHistory(w http.ResponseWriter, r *http.Request) {
 
-// GetGames operation middleware
-func (siw *ServerInterfaceWrapper) GetGames(w http.ResponseWriter, r *http.Request) {
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "roundNumber" -------------
+	var roundNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "roundNumber", r.PathValue("roundNumber"), &roundNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roundNumber", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tableNumber" -------------
+	var tableNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableNumber", r.PathValue("tableNumber"), &tableNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableNumber", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetGames(w, r)
+		siw.Handler.GetScoreHistory(w, r, gameID, roundNumber, tableNumber)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -346,11 +2716,41 @@ func (siw *ServerInterfaceWrapper) GetGames(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// CreateGame operation middleware
-func (siw *ServerInterfaceWrapper) CreateGame(w http.ResponseWriter, r *http.Request) {
+// UpdateSeatOrder operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSeatOrder(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "roundNumber" -------------
+	var roundNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "roundNumber", r.PathValue("roundNumber"), &roundNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roundNumber", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tableNumber" -------------
+	var tableNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tableNumber", r.PathValue("tableNumber"), &tableNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableNumber", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateGame(w, r)
+		siw.Handler.UpdateSeatOrder(w, r, gameID, roundNumber, tableNumber)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -360,8 +2760,8 @@ func (siw *ServerInterfaceWrapper) CreateGame(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteGame operation middleware
-func (siw *ServerInterfaceWrapper) DeleteGame(w http.ResponseWriter, r *http.Request) {
+// SetupGame operation middleware
+func (siw *ServerInterfaceWrapper) SetupGame(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -376,7 +2776,7 @@ func (siw *ServerInterfaceWrapper) DeleteGame(w http.ResponseWriter, r *http.Req
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteGame(w, r, gameID)
+		siw.Handler.SetupGame(w, r, gameID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -386,8 +2786,8 @@ func (siw *ServerInterfaceWrapper) DeleteGame(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetGame operation middleware
-func (siw *ServerInterfaceWrapper) GetGame(w http.ResponseWriter, r *http.Request) {
+// AcceptSetup operation middleware
+func (siw *ServerInterfaceWrapper) AcceptSetup(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -402,7 +2802,7 @@ func (siw *ServerInterfaceWrapper) GetGame(w http.ResponseWriter, r *http.Reques
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetGame(w, r, gameID)
+		siw.Handler.AcceptSetup(w, r, gameID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -412,8 +2812,8 @@ func (siw *ServerInterfaceWrapper) GetGame(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateGame operation middleware
-func (siw *ServerInterfaceWrapper) UpdateGame(w http.ResponseWriter, r *http.Request) {
+// PreviewSetup operation middleware
+func (siw *ServerInterfaceWrapper) PreviewSetup(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -428,7 +2828,7 @@ func (siw *ServerInterfaceWrapper) UpdateGame(w http.ResponseWriter, r *http.Req
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateGame(w, r, gameID)
+		siw.Handler.PreviewSetup(w, r, gameID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -438,8 +2838,8 @@ func (siw *ServerInterfaceWrapper) UpdateGame(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// AddOwner operation middleware
-func (siw *ServerInterfaceWrapper) AddOwner(w http.ResponseWriter, r *http.Request) {
+// GetSetupReport operation middleware
+func (siw *ServerInterfaceWrapper) GetSetupReport(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -454,7 +2854,7 @@ func (siw *ServerInterfaceWrapper) AddOwner(w http.ResponseWriter, r *http.Reque
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddOwner(w, r, gameID)
+		siw.Handler.GetSetupReport(w, r, gameID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -464,8 +2864,8 @@ func (siw *ServerInterfaceWrapper) AddOwner(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// RemoveOwner operation middleware
-func (siw *ServerInterfaceWrapper) RemoveOwner(w http.ResponseWriter, r *http.Request) {
+// VerifySetup operation middleware
+func (siw *ServerInterfaceWrapper) VerifySetup(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -479,17 +2879,34 @@ func (siw *ServerInterfaceWrapper) RemoveOwner(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// ------------- Path parameter "ownerSub" -------------
-	var ownerSub string
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifySetup(w, r, gameID)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ownerSub", r.PathValue("ownerSub"), &ownerSub, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeShare operation middleware
+func (siw *ServerInterfaceWrapper) RevokeShare(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ownerSub", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RemoveOwner(w, r, gameID, ownerSub)
+		siw.Handler.RevokeShare(w, r, gameID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -499,8 +2916,8 @@ func (siw *ServerInterfaceWrapper) RemoveOwner(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetTables operation middleware
-func (siw *ServerInterfaceWrapper) GetTables(w http.ResponseWriter, r *http.Request) {
+// GetShare operation middleware
+func (siw *ServerInterfaceWrapper) GetShare(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -514,17 +2931,34 @@ func (siw *ServerInterfaceWrapper) GetTables(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	// ------------- Path parameter "roundNumber" -------------
-	var roundNumber int
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetShare(w, r, gameID)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "roundNumber", r.PathValue("roundNumber"), &roundNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateShare operation middleware
+func (siw *ServerInterfaceWrapper) CreateShare(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roundNumber", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTables(w, r, gameID, roundNumber)
+		siw.Handler.CreateShare(w, r, gameID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -534,8 +2968,8 @@ func (siw *ServerInterfaceWrapper) GetTables(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// GetTable operation middleware
-func (siw *ServerInterfaceWrapper) GetTable(w http.ResponseWriter, r *http.Request) {
+// GetStages operation middleware
+func (siw *ServerInterfaceWrapper) GetStages(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -549,26 +2983,60 @@ func (siw *ServerInterfaceWrapper) GetTable(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	// ------------- Path parameter "roundNumber" -------------
-	var roundNumber int
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStages(w, r, gameID)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "roundNumber", r.PathValue("roundNumber"), &roundNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateStages operation middleware
+func (siw *ServerInterfaceWrapper) UpdateStages(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roundNumber", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "tableNumber" -------------
-	var tableNumber int
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateStages(w, r, gameID)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tableNumber", r.PathValue("tableNumber"), &tableNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AdvanceStage operation middleware
+func (siw *ServerInterfaceWrapper) AdvanceStage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableNumber", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTable(w, r, gameID, roundNumber, tableNumber)
+		siw.Handler.AdvanceStage(w, r, gameID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -578,8 +3046,8 @@ func (siw *ServerInterfaceWrapper) GetTable(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateScores operation middleware
-func (siw *ServerInterfaceWrapper) UpdateScores(w http.ResponseWriter, r *http.Request) {
+// GetStageStandings operation middleware
+func (siw *ServerInterfaceWrapper) GetStageStandings(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -593,26 +3061,59 @@ func (siw *ServerInterfaceWrapper) UpdateScores(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// ------------- Path parameter "roundNumber" -------------
-	var roundNumber int
+	// ------------- Path parameter "position" -------------
+	var position int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "roundNumber", r.PathValue("roundNumber"), &roundNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "position", r.PathValue("position"), &position, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "roundNumber", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "position", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "tableNumber" -------------
-	var tableNumber int
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStageStandings(w, r, gameID, position)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "tableNumber", r.PathValue("tableNumber"), &tableNumber, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStandings operation middleware
+func (siw *ServerInterfaceWrapper) GetStandings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tableNumber", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStandingsParams
+
+	// ------------- Optional query parameter "afterRound" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "afterRound", r.URL.Query(), &params.AfterRound, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "afterRound"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "afterRound", Err: err})
+		}
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateScores(w, r, gameID, roundNumber, tableNumber)
+		siw.Handler.GetStandings(w, r, gameID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -622,8 +3123,8 @@ func (siw *ServerInterfaceWrapper) UpdateScores(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// SetupGame operation middleware
-func (siw *ServerInterfaceWrapper) SetupGame(w http.ResponseWriter, r *http.Request) {
+// GetSubstitutions operation middleware
+func (siw *ServerInterfaceWrapper) GetSubstitutions(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -638,7 +3139,7 @@ func (siw *ServerInterfaceWrapper) SetupGame(w http.ResponseWriter, r *http.Requ
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetupGame(w, r, gameID)
+		siw.Handler.GetSubstitutions(w, r, gameID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -700,6 +3201,61 @@ func (siw *ServerInterfaceWrapper) CreateTeam(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
+// ImportTeams operation middleware
+func (siw *ServerInterfaceWrapper) ImportTeams(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "gameID" -------------
+	var gameID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "gameID", r.PathValue("gameID"), &gameID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gameID", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportTeamsParams
+
+	// ------------- Optional query parameter "delimiter" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "delimiter", r.URL.Query(), &params.Delimiter, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "delimiter"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "delimiter", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "header" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "header", r.URL.Query(), &params.Header, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "header"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "header", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportTeams(w, r, gameID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // DeleteTeam operation middleware
 func (siw *ServerInterfaceWrapper) DeleteTeam(w http.ResponseWriter, r *http.Request) {
 
@@ -1019,8 +3575,15 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{gameID}", wrapper.GetGame)
 	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/games/{gameID}", wrapper.UpdateGame)
 	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/{gameID}/setup", wrapper.SetupGame)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/{gameID}/setup/preview", wrapper.PreviewSetup)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/{gameID}/setup/accept", wrapper.AcceptSetup)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{gameID}/setup/report", wrapper.GetSetupReport)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{gameID}/setup/verify", wrapper.VerifySetup)
 	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/{gameID}/owners", wrapper.AddOwner)
 	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/games/{gameID}/owners/{ownerSub}", wrapper.RemoveOwner)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/{gameID}/players", wrapper.CreateGamePlayer)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/games/{gameID}/players/{playerID}", wrapper.DeleteGamePlayer)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/games/{gameID}/players/{playerID}", wrapper.UpdateGamePlayer)
 	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/{gameID}/teams", wrapper.CreateTeam)
 	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/games/{gameID}/teams/{teamID}", wrapper.DeleteTeam)
 	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/games/{gameID}/teams/{teamID}", wrapper.UpdateTeam)
@@ -1028,9 +3591,39 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/games/{gameID}/teams/{teamID}/players/{playerID}", wrapper.DeletePlayer)
 	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/games/{gameID}/teams/{teamID}/players/{playerID}", wrapper.UpdatePlayer)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{gameID}/tables", wrapper.GetGameTables)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/{gameID}/rounds/next", wrapper.NextRound)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/{gameID}/rounds/{roundNumber}/seats/move", wrapper.MovePlayer)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/{gameID}/rounds/{roundNumber}/seats/swap", wrapper.SwapSeats)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/{gameID}/rounds/{roundNumber}/start", wrapper.StartRound)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/{gameID}/rounds/{roundNumber}/close", wrapper.CloseRound)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/{gameID}/rounds/{roundNumber}/reassign", wrapper.ReassignRound)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/{gameID}/rounds/{roundNumber}/substitutions", wrapper.SubstitutePlayer)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{gameID}/rounds/{roundNumber}/tables", wrapper.GetTables)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{gameID}/rounds/{roundNumber}/tables/{tableNumber}", wrapper.GetTable)
 	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/games/{gameID}/rounds/{roundNumber}/tables/{tableNumber}/scores", wrapper.UpdateScores)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{gameID}/rounds/{roundNumber}/tables/{tableNumber}/scores/history", wrapper.GetScoreHistory)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/games/{gameID}/rounds/{roundNumber}/tables/{tableNumber}/seats", wrapper.UpdateSeatOrder)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{gameID}/standings", wrapper.GetStandings)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{gameID}/substitutions", wrapper.GetSubstitutions)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{gameID}/finals", wrapper.GetFinal)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/{gameID}/finals", wrapper.CreateFinal)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/{gameID}/finals/close", wrapper.CloseFinal)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{gameID}/finals/ranking", wrapper.GetFinalRanking)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/games/{gameID}/finals/tables/{tableNumber}/scores", wrapper.UpdateFinalScores)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{gameID}/stages", wrapper.GetStages)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/games/{gameID}/stages", wrapper.UpdateStages)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/{gameID}/stages/advance", wrapper.AdvanceStage)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{gameID}/stages/{position}/standings", wrapper.GetStageStandings)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/{gameID}/clone", wrapper.CloneGame)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{gameID}/export", wrapper.ExportGame)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/import", wrapper.ImportGame)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/{gameID}/teams/import", wrapper.ImportTeams)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{gameID}/results", wrapper.ExportResults)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{gameID}/rounds/{roundNumber}/seating-plan", wrapper.GetSeatingPlan)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{gameID}/rounds/{roundNumber}/score-sheets", wrapper.GetScoreSheets)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/games/{gameID}/share", wrapper.RevokeShare)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{gameID}/share", wrapper.GetShare)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/games/{gameID}/share", wrapper.CreateShare)
 
 	return m
 }
@@ -0,0 +1,202 @@
+package integrationtests
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+func TestFinals(t *testing.T) {
+	completePreliminaries := func(db *sql.DB) {
+		executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+
+		if _, err := db.ExecContext(t.Context(), `INSERT INTO scores (id, player_id, table_id, score) VALUES (8, 3, 4, 0);
+UPDATE rounds SET status = 'completed'`); err != nil {
+			t.Fatalf("Failed to complete preliminary rounds: %v", err)
+		}
+	}
+
+	withFinal := func(db *sql.DB, scores string) {
+		completePreliminaries(db)
+
+		if _, err := db.ExecContext(t.Context(), `INSERT INTO finals (id, game_id, finalists, status) VALUES (1, 1, 2, 'in_progress');
+INSERT INTO final_tables (id, final_id, table_number) VALUES (1, 1, 1);
+INSERT INTO final_players (final_table_id, player_id, seed, score) VALUES `+scores); err != nil {
+			t.Fatalf("Failed to create final: %v", err)
+		}
+	}
+
+	tests := map[string]testCase{
+		"Create final": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/finals",
+			requestBody:        `{"finalists":2}`,
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       `{"final":{"id":1,"gameID":1,"finalists":2,"status":"in_progress","tables":[{"id":1,"tableNumber":1,"players":[{"playerID":2,"seed":1},{"playerID":1,"seed":2}]}]}}`,
+			expectedHeaders:    map[string]string{"Location": "/games/1/finals"},
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup:              completePreliminaries,
+		},
+		"Create final with a round in progress": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/finals",
+			requestBody:        `{"finalists":2}`,
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Preliminary rounds are not completed"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Create final with more finalists than players": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/finals",
+			requestBody:        `{"finalists":5}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"error":"Invalid number of finalists"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup:              completePreliminaries,
+		},
+		"Create final twice": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/finals",
+			requestBody:        `{"finalists":2}`,
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Game already has a final"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				withFinal(db, "(1, 2, 1, NULL), (1, 1, 2, NULL)")
+			},
+		},
+		"Create final not game owner": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/finals",
+			requestBody:        `{"finalists":2}`,
+			expectedStatusCode: http.StatusForbidden,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-2"},
+			setup:              completePreliminaries,
+		},
+		"Get final": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/finals",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"final":{"id":1,"gameID":1,"finalists":2,"status":"in_progress","tables":[{"id":1,"tableNumber":1,"players":[{"playerID":2,"seed":1,"score":3},{"playerID":1,"seed":2}]}]}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				withFinal(db, "(1, 2, 1, 3), (1, 1, 2, NULL)")
+			},
+		},
+		"Get final without final": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/finals",
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       `{"error":"Final or table not found"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup:              completePreliminaries,
+		},
+		"Update final scores": {
+			method:             http.MethodPut,
+			endpoint:           "/games/1/finals/tables/1/scores",
+			requestBody:        `{"scores":[{"playerID":2,"score":3},{"playerID":1,"score":6}]}`,
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"table":{"id":1,"tableNumber":1,"players":[{"playerID":2,"seed":1,"score":3},{"playerID":1,"seed":2,"score":6}]}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				withFinal(db, "(1, 2, 1, NULL), (1, 1, 2, NULL)")
+			},
+		},
+		"Update final scores of a player not at the table": {
+			method:             http.MethodPut,
+			endpoint:           "/games/1/finals/tables/1/scores",
+			requestBody:        `{"scores":[{"playerID":2,"score":3},{"playerID":3,"score":6}]}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"error":"Invalid score"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				withFinal(db, "(1, 2, 1, NULL), (1, 1, 2, NULL)")
+			},
+		},
+		"Update final scores of an unknown table": {
+			method:             http.MethodPut,
+			endpoint:           "/games/1/finals/tables/2/scores",
+			requestBody:        `{"scores":[{"playerID":2,"score":3},{"playerID":1,"score":6}]}`,
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       `{"error":"Final or table not found"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				withFinal(db, "(1, 2, 1, NULL), (1, 1, 2, NULL)")
+			},
+		},
+		"Close final with missing scores": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/finals/close",
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Final has missing scores"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				withFinal(db, "(1, 2, 1, 3), (1, 1, 2, NULL)")
+			},
+		},
+		"Close final": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/finals/close",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"final":{"id":1,"gameID":1,"finalists":2,"status":"completed","tables":[{"id":1,"tableNumber":1,"players":[{"playerID":2,"seed":1,"score":3},{"playerID":1,"seed":2,"score":6}]}]}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				withFinal(db, "(1, 2, 1, 3), (1, 1, 2, 6)")
+			},
+		},
+		"Get final ranking": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/finals/ranking",
+			expectedStatusCode: http.StatusOK,
+			expectedBody: `{"ranking":{"gameID":1,"complete":true,"players":[
+{"rank":1,"playerID":1,"name":"Player 1","teamID":1,"finalist":true,"seed":2,"finalScore":6,"preliminaryRank":2,"preliminaryScore":7},
+{"rank":2,"playerID":2,"name":"Player 2","teamID":1,"finalist":true,"seed":1,"finalScore":3,"preliminaryRank":1,"preliminaryScore":8},
+{"rank":3,"playerID":3,"name":"Player 3","teamID":2,"finalist":false,"preliminaryRank":3,"preliminaryScore":5},
+{"rank":4,"playerID":4,"name":"Player 4","teamID":2,"finalist":false,"preliminaryRank":4,"preliminaryScore":3}]}}`,
+			requestHeaders: map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				withFinal(db, "(1, 2, 1, 3), (1, 1, 2, 6)")
+			},
+		},
+		"Get final ranking not game owner": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/finals/ranking",
+			expectedStatusCode: http.StatusForbidden,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-2"},
+			setup: func(db *sql.DB) {
+				withFinal(db, "(1, 2, 1, 3), (1, 1, 2, 6)")
+			},
+		},
+	}
+
+	dbConn, teardownDatabase := setupTestDatabase(t)
+	defer teardownDatabase()
+
+	db, err := sql.Open("pgx", dbConn)
+	if err != nil {
+		t.Fatalf("Failed to open database connection: %v", err)
+	}
+
+	defer db.Close()
+
+	runGooseUp(t, db)
+
+	server, teardown := setupTestServer(t)
+	defer teardown(server)
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup(db)
+			}
+
+			defer executeSQLFile(t, db, "./test_data/cleanup.sql")
+			newTestRequest(t, tc, server, db)
+		})
+	}
+}
@@ -2,10 +2,17 @@ package integrationtests
 
 import (
 	"database/sql"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/henok321/knobel-manager-service/gen/api"
+	"github.com/henok321/knobel-manager-service/pkg/setup"
 )
 
 func TestGameSetup(t *testing.T) {
@@ -19,6 +26,298 @@ func TestGameSetup(t *testing.T) {
 				executeSQLFile(t, db, "./test_data/games_setup_ready.sql")
 			},
 		},
+		"Setup game tables with seed": {
+			method:             "POST",
+			endpoint:           "/games/1/setup",
+			requestBody:        `{"seed":42}`,
+			expectedStatusCode: http.StatusNoContent,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_ready.sql")
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var drawSeed int64
+
+				var drawAlgorithm string
+
+				if err := db.QueryRowContext(t.Context(), "SELECT draw_seed, draw_algorithm FROM games WHERE id = 1").Scan(&drawSeed, &drawAlgorithm); err != nil {
+					t.Fatalf("Failed to query draw: %v", err)
+				}
+
+				assert.Equal(t, int64(42), drawSeed)
+				assert.Equal(t, setup.Algorithm, drawAlgorithm)
+			},
+		},
+		"Setup game tables again keeps score history": {
+			method:             "POST",
+			endpoint:           "/games/1/setup",
+			expectedStatusCode: http.StatusNoContent,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+				if _, err := db.ExecContext(t.Context(), `INSERT INTO score_history (table_id, game_id, round_number, table_number, player_id, player_name, new_score, owner_sub)
+					VALUES (1, 1, 1, 1, 1, 'Player 1', 3, 'sub-1')`); err != nil {
+					t.Fatalf("Failed to insert score history: %v", err)
+				}
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var count int
+
+				if err := db.QueryRowContext(t.Context(), "SELECT count(*) FROM score_history WHERE game_id = 1 AND round_number = 1 AND table_number = 1 AND table_id IS NULL").Scan(&count); err != nil {
+					t.Fatalf("Failed to query score history: %v", err)
+				}
+
+				assert.Equal(t, 1, count, "the history of the deleted table is kept")
+			},
+		},
+		"Setup game tables with a cancelled player and byes": {
+			method:             "POST",
+			endpoint:           "/games/1/setup",
+			expectedStatusCode: http.StatusNoContent,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_ready.sql")
+
+				if _, err := db.ExecContext(t.Context(), "DELETE FROM players WHERE id = 32; UPDATE games SET byes = TRUE WHERE id = 1"); err != nil {
+					t.Fatalf("Failed to prepare setup: %v", err)
+				}
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var byes, shortTables int
+
+				if err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM round_byes").Scan(&byes); err != nil {
+					t.Fatalf("Failed to count byes: %v", err)
+				}
+
+				if err := db.QueryRowContext(t.Context(), `SELECT COUNT(*) FROM (
+    SELECT game_table_id FROM table_players GROUP BY game_table_id HAVING COUNT(*) < 4
+) AS short_tables`).Scan(&shortTables); err != nil {
+					t.Fatalf("Failed to count short tables: %v", err)
+				}
+
+				assert.Equal(t, 6, byes, "three players sit out each of the two rounds")
+				assert.Equal(t, 0, shortTables)
+			},
+		},
+		"Setup game tables with a cancelled player and short tables": {
+			method:             "POST",
+			endpoint:           "/games/1/setup",
+			expectedStatusCode: http.StatusNoContent,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_ready.sql")
+
+				if _, err := db.ExecContext(t.Context(), "DELETE FROM players WHERE id = 32; UPDATE games SET short_tables = TRUE WHERE id = 1"); err != nil {
+					t.Fatalf("Failed to prepare setup: %v", err)
+				}
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var byes, shortTables int
+
+				if err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM round_byes").Scan(&byes); err != nil {
+					t.Fatalf("Failed to count byes: %v", err)
+				}
+
+				if err := db.QueryRowContext(t.Context(), `SELECT COUNT(*) FROM (
+    SELECT game_table_id FROM table_players GROUP BY game_table_id HAVING COUNT(*) = 3
+) AS short_tables`).Scan(&shortTables); err != nil {
+					t.Fatalf("Failed to count short tables: %v", err)
+				}
+
+				assert.Equal(t, 0, byes)
+				assert.Equal(t, 2, shortTables, "one short table in each of the two rounds")
+			},
+		},
+		"Setup game tables with a table layout": {
+			method:             "POST",
+			endpoint:           "/games/1/setup",
+			expectedStatusCode: http.StatusNoContent,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_ready.sql")
+
+				if _, err := db.ExecContext(t.Context(), "UPDATE games SET table_layout = '5,5,5,5,4,4,4' WHERE id = 1"); err != nil {
+					t.Fatalf("Failed to prepare setup: %v", err)
+				}
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var tables, fullTables int
+
+				if err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM game_tables").Scan(&tables); err != nil {
+					t.Fatalf("Failed to count tables: %v", err)
+				}
+
+				if err := db.QueryRowContext(t.Context(), `SELECT COUNT(*) FROM (
+    SELECT game_tables.id FROM game_tables
+    JOIN table_players ON table_players.game_table_id = game_tables.id
+    GROUP BY game_tables.id, game_tables.capacity
+    HAVING COUNT(*) = game_tables.capacity
+) AS full_tables`).Scan(&fullTables); err != nil {
+					t.Fatalf("Failed to count full tables: %v", err)
+				}
+
+				assert.Equal(t, 14, tables, "seven tables in each of the two rounds")
+				assert.Equal(t, 14, fullTables, "every table seats as many players as it has seats")
+			},
+		},
+		"Setup game tables with a table layout that does not seat all players": {
+			method:             "POST",
+			endpoint:           "/games/1/setup",
+			expectedStatusCode: http.StatusConflict,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_ready.sql")
+
+				if _, err := db.ExecContext(t.Context(), "UPDATE games SET table_layout = '5,5,5,5' WHERE id = 1"); err != nil {
+					t.Fatalf("Failed to prepare setup: %v", err)
+				}
+			},
+		},
+		"Setup game tables with a cancelled player": {
+			method:             "POST",
+			endpoint:           "/games/1/setup",
+			expectedStatusCode: http.StatusConflict,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_ready.sql")
+
+				if _, err := db.ExecContext(t.Context(), "DELETE FROM players WHERE id = 32"); err != nil {
+					t.Fatalf("Failed to prepare setup: %v", err)
+				}
+			},
+		},
+		"Setup game tables with invalid body": {
+			method:             "POST",
+			endpoint:           "/games/1/setup",
+			requestBody:        `{"seed":"abc"}`,
+			expectedStatusCode: http.StatusBadRequest,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_ready.sql")
+			},
+		},
+		"Get setup report": {
+			method:             "GET",
+			endpoint:           "/games/1/setup/report",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       readContentFromFile(t, "./test_data/setup_report.json"),
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_report.sql")
+			},
+		},
+		"Get setup report before setup": {
+			method:             "GET",
+			endpoint:           "/games/1/setup/report",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"report":{"playerEncounters":{"ids":[],"counts":[]},"teamEncounters":{"ids":[],"counts":[]},"maxPlayerEncounters":0,"maxTeamEncounters":0,"tableSpread":[],"violations":[]}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup.sql")
+			},
+		},
+		"Get setup report without permissions": {
+			method:             "GET",
+			endpoint:           "/games/1/setup/report",
+			expectedStatusCode: http.StatusForbidden,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-2"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_report.sql")
+			},
+		},
+		"Verify setup without recorded draw": {
+			method:             "GET",
+			endpoint:           "/games/1/setup/verify",
+			expectedStatusCode: http.StatusConflict,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"Verify setup drawn by another algorithm": {
+			method:             "GET",
+			endpoint:           "/games/1/setup/verify",
+			expectedStatusCode: http.StatusConflict,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+
+				if _, err := db.ExecContext(t.Context(), "UPDATE games SET draw_seed = 42, draw_algorithm = 'shuffle-v0' WHERE id = 1"); err != nil {
+					t.Fatalf("Failed to record draw: %v", err)
+				}
+			},
+		},
+		"Verify setup without permissions": {
+			method:             "GET",
+			endpoint:           "/games/1/setup/verify",
+			expectedStatusCode: http.StatusForbidden,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-2"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"Preview game setup": {
+			method:             "POST",
+			endpoint:           "/games/1/setup/preview",
+			requestBody:        `{"seed":42}`,
+			expectedStatusCode: http.StatusOK,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_ready.sql")
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var rounds int
+
+				if err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM rounds WHERE game_id = 1").Scan(&rounds); err != nil {
+					t.Fatalf("Failed to count rounds: %v", err)
+				}
+
+				assert.Equal(t, 0, rounds, "a preview assigns no tables")
+			},
+		},
+		"Preview game setup without permissions": {
+			method:             "POST",
+			endpoint:           "/games/1/setup/preview",
+			expectedStatusCode: http.StatusForbidden,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-2"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_ready.sql")
+			},
+		},
+		"Accept outdated game setup preview": {
+			method:             "POST",
+			endpoint:           "/games/1/setup/accept",
+			requestBody:        `{"seed":42,"token":"outdated"}`,
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Draw preview is outdated"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_ready.sql")
+			},
+		},
+		"Accept game setup preview without token": {
+			method:             "POST",
+			endpoint:           "/games/1/setup/accept",
+			requestBody:        `{"seed":42}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"error":"Invalid request body"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_ready.sql")
+			},
+		},
 		"Try to setup game tables with out permissions": {
 			method:             "POST",
 			endpoint:           "/games/1/setup",
@@ -120,3 +419,198 @@ func TestGameSetupMultipleTimes(t *testing.T) {
 		newTestRequest(t, tc, server, db)
 	})
 }
+
+func TestGameSetupVerify(t *testing.T) {
+	dbConn, teardownDatabase := setupTestDatabase(t)
+	defer teardownDatabase()
+
+	db, err := sql.Open("pgx", dbConn)
+	if err != nil {
+		t.Fatalf("Failed to open database connection: %v", err)
+	}
+	defer db.Close()
+
+	runGooseUp(t, db)
+
+	server, teardown := setupTestServer(t)
+	defer teardown(server)
+
+	executeSQLFile(t, db, "./test_data/games_setup_ready.sql")
+	defer executeSQLFile(t, db, "./test_data/cleanup.sql")
+
+	t.Run("Setup with seed", func(t *testing.T) {
+		newTestRequest(t, testCase{
+			method:             "POST",
+			endpoint:           "/games/1/setup",
+			requestBody:        `{"seed":42}`,
+			expectedStatusCode: http.StatusNoContent,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+		}, server, db)
+	})
+
+	t.Run("Verify untouched draw", func(t *testing.T) {
+		newTestRequest(t, testCase{
+			method:             "GET",
+			endpoint:           "/games/1/setup/verify",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"verification":{"seed":42,"algorithm":"` + setup.Algorithm + `","matches":true,"mismatches":[]}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+		}, server, db)
+	})
+
+	t.Run("Verify tampered draw", func(t *testing.T) {
+		_, err := db.ExecContext(t.Context(), `DELETE FROM table_players
+WHERE game_table_id = (
+    SELECT game_tables.id FROM game_tables
+    JOIN rounds ON rounds.id = game_tables.round_id
+    WHERE rounds.game_id = 1 AND rounds.round_number = 2 AND game_tables.table_number = 1
+)`)
+		if err != nil {
+			t.Fatalf("Failed to tamper with table: %v", err)
+		}
+
+		newTestRequest(t, testCase{
+			method:             "GET",
+			endpoint:           "/games/1/setup/verify",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"verification":{"seed":42,"algorithm":"` + setup.Algorithm + `","matches":false,"mismatches":[{"roundNumber":2,"tableNumber":1}]}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+		}, server, db)
+	})
+}
+
+func TestGameSetupPreviewAndAccept(t *testing.T) {
+	dbConn, teardownDatabase := setupTestDatabase(t)
+	defer teardownDatabase()
+
+	db, err := sql.Open("pgx", dbConn)
+	if err != nil {
+		t.Fatalf("Failed to open database connection: %v", err)
+	}
+	defer db.Close()
+
+	runGooseUp(t, db)
+
+	server, teardown := setupTestServer(t)
+	defer teardown(server)
+
+	executeSQLFile(t, db, "./test_data/games_setup_ready.sql")
+	defer executeSQLFile(t, db, "./test_data/cleanup.sql")
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, server.URL+"/games/1/setup/preview", strings.NewReader(`{"seed":42}`))
+	if err != nil {
+		t.Fatalf("Failed to create preview request: %v", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer sub-1")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to perform preview request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var previewResponse api.SetupPreviewResponse
+	if err := json.NewDecoder(resp.Body).Decode(&previewResponse); err != nil {
+		t.Fatalf("Failed to decode preview: %v", err)
+	}
+
+	preview := previewResponse.Preview
+	assert.Equal(t, int64(42), preview.Seed)
+	assert.NotEmpty(t, preview.Token)
+
+	newTestRequest(t, testCase{
+		method:             http.MethodPost,
+		endpoint:           "/games/1/setup/accept",
+		requestBody:        fmt.Sprintf(`{"seed":%d,"token":%q}`, preview.Seed, preview.Token),
+		expectedStatusCode: http.StatusNoContent,
+		requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+		assertions: func(t *testing.T, db *sql.DB) {
+			t.Helper()
+
+			for _, round := range preview.Rounds {
+				for _, table := range round.Tables {
+					for _, player := range table.Players {
+						var tableNumber int
+
+						if err := db.QueryRowContext(t.Context(), `SELECT gt.table_number FROM table_players tp
+							JOIN game_tables gt ON gt.id = tp.game_table_id
+							JOIN rounds r ON r.id = gt.round_id
+							WHERE r.round_number = $1 AND tp.player_id = $2`, round.RoundNumber, player.Id).Scan(&tableNumber); err != nil {
+							t.Fatalf("Failed to query seat: %v", err)
+						}
+
+						assert.Equal(t, table.TableNumber, tableNumber, "the accepted tables are the previewed ones")
+					}
+				}
+			}
+		},
+	}, server, db)
+}
+
+func TestGameSetupIndividual(t *testing.T) {
+	dbConn, teardownDatabase := setupTestDatabase(t)
+	defer teardownDatabase()
+
+	db, err := sql.Open("pgx", dbConn)
+	if err != nil {
+		t.Fatalf("Failed to open database connection: %v", err)
+	}
+	defer db.Close()
+
+	runGooseUp(t, db)
+
+	server, teardown := setupTestServer(t)
+	defer teardown(server)
+
+	defer executeSQLFile(t, db, "./test_data/cleanup.sql")
+
+	t.Run("Create individual game", func(t *testing.T) {
+		newTestRequest(t, testCase{
+			method:             http.MethodPost,
+			endpoint:           "/games",
+			requestBody:        `{"name":"Game 1","numberOfRounds":2,"teamSize":1,"tableSize":4,"mode":"individual"}`,
+			expectedStatusCode: http.StatusCreated,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedHeaders:    map[string]string{"Location": "/games/1"},
+		}, server, db)
+	})
+
+	t.Run("Add players", func(t *testing.T) {
+		for i := 1; i <= 8; i++ {
+			newTestRequest(t, testCase{
+				method:             http.MethodPost,
+				endpoint:           "/games/1/players",
+				requestBody:        fmt.Sprintf(`{"name":"Player %d"}`, i),
+				expectedStatusCode: http.StatusCreated,
+				requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			}, server, db)
+		}
+	})
+
+	t.Run("Setup game tables", func(t *testing.T) {
+		newTestRequest(t, testCase{
+			method:             http.MethodPost,
+			endpoint:           "/games/1/setup",
+			requestBody:        `{"seed":42}`,
+			expectedStatusCode: http.StatusNoContent,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var tables, seats int
+
+				if err := db.QueryRowContext(t.Context(), `SELECT COUNT(DISTINCT gt.id), COUNT(*) FROM table_players tp
+					JOIN game_tables gt ON gt.id = tp.game_table_id
+					JOIN rounds r ON r.id = gt.round_id
+					WHERE r.game_id = 1`).Scan(&tables, &seats); err != nil {
+					t.Fatalf("Failed to count seats: %v", err)
+				}
+
+				assert.Equal(t, 4, tables, "two tables in each of the two rounds")
+				assert.Equal(t, 16, seats, "every player is seated in every round")
+			},
+		}, server, db)
+	})
+}
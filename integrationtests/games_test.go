@@ -80,9 +80,26 @@ func TestGames(t *testing.T) {
 			expectedStatusCode: http.StatusCreated,
 			requestBody:        `{"name":"Game 1","numberOfRounds":2, "teamSize":4, "tableSize":4}`,
 			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
-			expectedBody:       `{"game":{"id":1,"name":"Game 1","teamSize":4,"tableSize":4,"numberOfRounds":2,"status":"setup","owners":[{"gameID":1,"ownerSub":"sub-1","email":"sub-1@example.org"}]}}`,
+			expectedBody:       `{"game":{"id":1,"name":"Game 1","teamSize":4,"tableSize":4,"numberOfRounds":2,"status":"setup","pairingMode":"random","mode":"team","ranking":{"scoreDirection":"higher_wins","tieBreakers":[]},"seating":{"shortTables":false,"byes":false,"byeScore":0},"owners":[{"gameID":1,"ownerSub":"sub-1","email":"sub-1@example.org"}]}}`,
 			expectedHeaders:    map[string]string{"Location": "/games/1"},
 		},
+		"Create new individual game": {
+			method:             http.MethodPost,
+			endpoint:           "/games",
+			expectedStatusCode: http.StatusCreated,
+			requestBody:        `{"name":"Game 1","numberOfRounds":2, "teamSize":4, "tableSize":4, "mode":"individual"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedBody:       `{"game":{"id":1,"name":"Game 1","teamSize":1,"tableSize":4,"numberOfRounds":2,"status":"setup","pairingMode":"random","mode":"individual","ranking":{"scoreDirection":"higher_wins","tieBreakers":[]},"seating":{"shortTables":false,"byes":false,"byeScore":0},"owners":[{"gameID":1,"ownerSub":"sub-1","email":"sub-1@example.org"}]}}`,
+			expectedHeaders:    map[string]string{"Location": "/games/1"},
+		},
+		"Create new game invalid mode": {
+			method:             http.MethodPost,
+			endpoint:           "/games",
+			expectedStatusCode: http.StatusBadRequest,
+			requestBody:        `{"name":"Game 1","numberOfRounds":2, "teamSize":4, "tableSize":4, "mode":"pairs"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedBody:       `{"error":"Invalid game mode"}`,
+		},
 		"Create new game invalid request": {
 			method:             http.MethodPost,
 			endpoint:           "/games",
@@ -96,7 +113,102 @@ func TestGames(t *testing.T) {
 			requestBody:        `{"name":"Game 1 updated","numberOfRounds":3, "teamSize":4, "tableSize":4}`,
 			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
 			expectedStatusCode: http.StatusOK,
-			expectedBody:       `{"game":{"id":1,"name":"Game 1 updated","teamSize":4,"tableSize":4,"numberOfRounds":3,"status":"setup","owners":[{"gameID":1,"ownerSub":"sub-1","email":"sub-1@example.org"}]}}`,
+			expectedBody:       `{"game":{"id":1,"name":"Game 1 updated","teamSize":4,"tableSize":4,"numberOfRounds":3,"status":"setup","pairingMode":"random","mode":"team","ranking":{"scoreDirection":"higher_wins","tieBreakers":[]},"seating":{"shortTables":false,"byes":false,"byeScore":0},"owners":[{"gameID":1,"ownerSub":"sub-1","email":"sub-1@example.org"}]}}`,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup.sql")
+			},
+		},
+		"Update the ranking of a game": {
+			method:             http.MethodPut,
+			endpoint:           "/games/1",
+			requestBody:        `{"name":"Game 1","numberOfRounds":2, "teamSize":4, "tableSize":4, "ranking":{"scoreDirection":"lower_wins","tieBreakers":["head_to_head","coin_flip"],"coinFlipSeed":7}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"game":{"id":1,"name":"Game 1","teamSize":4,"tableSize":4,"numberOfRounds":2,"status":"setup","pairingMode":"random","mode":"team","ranking":{"scoreDirection":"lower_wins","tieBreakers":["head_to_head","coin_flip"],"coinFlipSeed":7},"seating":{"shortTables":false,"byes":false,"byeScore":0},"owners":[{"gameID":1,"ownerSub":"sub-1","email":"sub-1@example.org"}]}}`,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup.sql")
+			},
+		},
+		"Update the seating of a game": {
+			method:             http.MethodPut,
+			endpoint:           "/games/1",
+			requestBody:        `{"name":"Game 1","numberOfRounds":2, "teamSize":4, "tableSize":4, "seating":{"shortTables":true,"byes":true,"byeScore":2}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"game":{"id":1,"name":"Game 1","teamSize":4,"tableSize":4,"numberOfRounds":2,"status":"setup","pairingMode":"random","mode":"team","ranking":{"scoreDirection":"higher_wins","tieBreakers":[]},"seating":{"shortTables":true,"byes":true,"byeScore":2},"owners":[{"gameID":1,"ownerSub":"sub-1","email":"sub-1@example.org"}]}}`,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup.sql")
+			},
+		},
+		"Update the seating of a game with drawn rounds": {
+			method:             http.MethodPut,
+			endpoint:           "/games/1",
+			requestBody:        `{"name":"Game 1","numberOfRounds":2, "teamSize":2, "tableSize":2, "seating":{"shortTables":true,"byes":false,"byeScore":0}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Seating cannot be changed after rounds have been drawn"}`,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Update the table layout of a game with drawn rounds": {
+			method:             http.MethodPut,
+			endpoint:           "/games/1",
+			requestBody:        `{"name":"Game 1","numberOfRounds":2, "teamSize":2, "tableSize":2, "tableLayout":[2,2]}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusConflict,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Update a game with drawn rounds keeping its seating": {
+			method:             http.MethodPut,
+			endpoint:           "/games/1",
+			requestBody:        `{"name":"Game 1 renamed","numberOfRounds":2, "teamSize":2, "tableSize":2, "seating":{"shortTables":false,"byes":false,"byeScore":0}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusOK,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Update the table layout of a game": {
+			method:             http.MethodPut,
+			endpoint:           "/games/1",
+			requestBody:        `{"name":"Game 1","numberOfRounds":2, "teamSize":4, "tableSize":4, "tableLayout":[5,5,6]}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"game":{"id":1,"name":"Game 1","teamSize":4,"tableSize":4,"numberOfRounds":2,"status":"setup","pairingMode":"random","mode":"team","ranking":{"scoreDirection":"higher_wins","tieBreakers":[]},"seating":{"shortTables":false,"byes":false,"byeScore":0},"tableLayout":[5,5,6],"owners":[{"gameID":1,"ownerSub":"sub-1","email":"sub-1@example.org"}]}}`,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup.sql")
+			},
+		},
+		"Update the table layout of a game with a single seat table": {
+			method:             http.MethodPut,
+			endpoint:           "/games/1",
+			requestBody:        `{"name":"Game 1","numberOfRounds":2, "teamSize":4, "tableSize":4, "tableLayout":[5,1]}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"error":"Invalid table layout"}`,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup.sql")
+			},
+		},
+		"Update the ranking of a game with duplicate tie-breakers": {
+			method:             http.MethodPut,
+			endpoint:           "/games/1",
+			requestBody:        `{"name":"Game 1","numberOfRounds":2, "teamSize":4, "tableSize":4, "ranking":{"scoreDirection":"higher_wins","tieBreakers":["best_round","best_round"]}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusBadRequest,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup.sql")
+			},
+		},
+		"Update the ranking of a game with unknown score direction": {
+			method:             http.MethodPut,
+			endpoint:           "/games/1",
+			requestBody:        `{"name":"Game 1","numberOfRounds":2, "teamSize":4, "tableSize":4, "ranking":{"scoreDirection":"sideways","tieBreakers":[]}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusBadRequest,
 			setup: func(db *sql.DB) {
 				executeSQLFile(t, db, "./test_data/games_setup.sql")
 			},
@@ -107,7 +219,7 @@ func TestGames(t *testing.T) {
 			requestBody:        `{"name":"Game 1","numberOfRounds":2, "teamSize":4, "tableSize":4, "status":"in_progress"}`,
 			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
 			expectedStatusCode: http.StatusOK,
-			expectedBody:       `{"game":{"id":1,"name":"Game 1","teamSize":4,"tableSize":4,"numberOfRounds":2,"status":"in_progress","owners":[{"gameID":1,"ownerSub":"sub-1","email":"sub-1@example.org"}]}}`,
+			expectedBody:       `{"game":{"id":1,"name":"Game 1","teamSize":4,"tableSize":4,"numberOfRounds":2,"status":"in_progress","pairingMode":"random","mode":"team","ranking":{"scoreDirection":"higher_wins","tieBreakers":[]},"seating":{"shortTables":false,"byes":false,"byeScore":0},"owners":[{"gameID":1,"ownerSub":"sub-1","email":"sub-1@example.org"}]}}`,
 			setup: func(db *sql.DB) {
 				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
 			},
@@ -171,7 +283,7 @@ func TestGames(t *testing.T) {
 			requestBody:        `{"name":"Game 1","numberOfRounds":1, "teamSize":4, "tableSize":4, "status":"completed"}`,
 			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
 			expectedStatusCode: http.StatusOK,
-			expectedBody:       `{"game":{"id":1,"name":"Game 1","teamSize":4,"tableSize":4,"numberOfRounds":1,"status":"completed","owners":[{"gameID":1,"ownerSub":"sub-1","email":"sub-1@example.org"}]}}`,
+			expectedBody:       `{"game":{"id":1,"name":"Game 1","teamSize":4,"tableSize":4,"numberOfRounds":1,"status":"completed","pairingMode":"random","mode":"team","ranking":{"scoreDirection":"higher_wins","tieBreakers":[]},"seating":{"shortTables":false,"byes":false,"byeScore":0},"owners":[{"gameID":1,"ownerSub":"sub-1","email":"sub-1@example.org"}]}}`,
 			setup: func(db *sql.DB) {
 				executeSQLFile(t, db, "./test_data/games_setup_assigned_scores_entered.sql")
 			},
@@ -232,6 +344,162 @@ func TestGames(t *testing.T) {
 				executeSQLFile(t, db, "./test_data/games_setup.sql")
 			},
 		},
+		"Clone a game": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/clone",
+			requestBody:        `{"name":"Game 2"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       `{"game":{"id":2,"name":"Game 2","teamSize":2,"tableSize":4,"numberOfRounds":1,"status":"setup","pairingMode":"random","mode":"team","ranking":{"scoreDirection":"higher_wins","tieBreakers":[]},"seating":{"shortTables":false,"byes":false,"byeScore":0},"owners":[{"gameID":2,"ownerSub":"sub-1","email":"sub-1@example.org"},{"gameID":2,"ownerSub":"sub-2","email":"sub-2@example.org"}],"teams":[{"id":3,"gameID":2,"name":"Team 1","players":[{"id":5,"name":"Player 1","teamID":3},{"id":6,"name":"Player 2","teamID":3}]},{"id":4,"gameID":2,"name":"Team 2","players":[{"id":7,"name":"Player 3","teamID":4},{"id":8,"name":"Player 4","teamID":4,"substitute":true}]}]}}`,
+			expectedHeaders:    map[string]string{"Location": "/games/2"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_clone.sql")
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var rounds int
+
+				if err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM rounds WHERE game_id = 2").Scan(&rounds); err != nil {
+					t.Fatalf("Failed to query rounds: %v", err)
+				}
+
+				assert.Equal(t, 0, rounds)
+			},
+		},
+		"Clone a game without a body keeps the name": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/clone",
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       `{"game":{"id":2,"name":"Game 1","teamSize":2,"tableSize":4,"numberOfRounds":1,"status":"setup","pairingMode":"random","mode":"team","ranking":{"scoreDirection":"higher_wins","tieBreakers":[]},"seating":{"shortTables":false,"byes":false,"byeScore":0},"owners":[{"gameID":2,"ownerSub":"sub-1","email":"sub-1@example.org"},{"gameID":2,"ownerSub":"sub-2","email":"sub-2@example.org"}],"teams":[{"id":3,"gameID":2,"name":"Team 1","players":[{"id":5,"name":"Player 1","teamID":3},{"id":6,"name":"Player 2","teamID":3}]},{"id":4,"gameID":2,"name":"Team 2","players":[{"id":7,"name":"Player 3","teamID":4},{"id":8,"name":"Player 4","teamID":4,"substitute":true}]}]}}`,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_clone.sql")
+			},
+		},
+		"Clone a game excluding a team": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/clone",
+			requestBody:        `{"excludeTeams":[2]}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       `{"game":{"id":2,"name":"Game 1","teamSize":2,"tableSize":4,"numberOfRounds":1,"status":"setup","pairingMode":"random","mode":"team","ranking":{"scoreDirection":"higher_wins","tieBreakers":[]},"seating":{"shortTables":false,"byes":false,"byeScore":0},"owners":[{"gameID":2,"ownerSub":"sub-1","email":"sub-1@example.org"},{"gameID":2,"ownerSub":"sub-2","email":"sub-2@example.org"}],"teams":[{"id":3,"gameID":2,"name":"Team 1","players":[{"id":5,"name":"Player 1","teamID":3},{"id":6,"name":"Player 2","teamID":3}]}]}}`,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_clone.sql")
+			},
+		},
+		"Clone a game excluding an unknown team": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/clone",
+			requestBody:        `{"excludeTeams":[5]}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       `{"error":"Team not found"}`,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_clone.sql")
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var games int
+
+				if err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM games").Scan(&games); err != nil {
+					t.Fatalf("Failed to query games: %v", err)
+				}
+
+				assert.Equal(t, 1, games)
+			},
+		},
+		"Clone a game not found": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/clone",
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		"Clone a game not owner": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/clone",
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-3"},
+			expectedStatusCode: http.StatusForbidden,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_clone.sql")
+			},
+		},
+		"Export a game": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/export",
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"version":1,"game":{"name":"Game 1","teamSize":2,"tableSize":4,"numberOfRounds":1,"status":"in_progress","mode":"team","pairingMode":"random","ranking":{"scoreDirection":"higher_wins","tieBreakers":[]},"seating":{"shortTables":false,"byes":false,"byeScore":0},"teams":[{"id":1,"name":"Team 1","players":[{"id":1,"name":"Player 1"},{"id":2,"name":"Player 2"}]},{"id":2,"name":"Team 2","players":[{"id":3,"name":"Player 3"},{"id":4,"name":"Player 4","substitute":true}]}],"rounds":[{"roundNumber":1,"status":"completed"}]}}`,
+			expectedHeaders:    map[string]string{"Content-Disposition": `attachment; filename="game-1.json"`},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_clone.sql")
+			},
+		},
+		"Export a game not owner": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/export",
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-3"},
+			expectedStatusCode: http.StatusForbidden,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_clone.sql")
+			},
+		},
+		"Import a game": {
+			method:             http.MethodPost,
+			endpoint:           "/games/import",
+			requestBody:        readContentFromFile(t, "./test_data/game_archive.json"),
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       readContentFromFile(t, "./test_data/game_archive_imported.json"),
+			expectedHeaders:    map[string]string{"Location": "/games/1"},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				counts := map[string]int{
+					"stage_players": 2,
+					"table_players": 2,
+					"scores":        2,
+					"round_byes":    1,
+					"substitutions": 1,
+					"final_players": 2,
+				}
+
+				for table, expected := range counts {
+					var count int
+
+					if err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM "+table).Scan(&count); err != nil {
+						t.Fatalf("Failed to query %s: %v", table, err)
+					}
+
+					assert.Equal(t, expected, count, table)
+				}
+
+				var drawSeed sql.NullInt64
+
+				if err := db.QueryRowContext(t.Context(), "SELECT draw_seed FROM games WHERE id = 1").Scan(&drawSeed); err != nil {
+					t.Fatalf("Failed to query draw seed: %v", err)
+				}
+
+				assert.False(t, drawSeed.Valid, "the draw of an imported game cannot be verified")
+			},
+		},
+		"Import a game with an unsupported archive version": {
+			method:             http.MethodPost,
+			endpoint:           "/games/import",
+			requestBody:        `{"version":2,"game":{"name":"Game 1","teamSize":4,"tableSize":4,"numberOfRounds":1,"status":"setup","mode":"team","pairingMode":"random","ranking":{"scoreDirection":"higher_wins","tieBreakers":[]},"seating":{"shortTables":false,"byes":false,"byeScore":0}}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"error":"Unsupported archive version"}`,
+		},
+		"Import a game with scores of an unknown player": {
+			method:             http.MethodPost,
+			endpoint:           "/games/import",
+			requestBody:        `{"version":1,"game":{"name":"Game 1","teamSize":4,"tableSize":4,"numberOfRounds":1,"status":"setup","mode":"team","pairingMode":"random","ranking":{"scoreDirection":"higher_wins","tieBreakers":[]},"seating":{"shortTables":false,"byes":false,"byeScore":0},"rounds":[{"roundNumber":1,"status":"setup","tables":[{"tableNumber":1,"capacity":4,"scores":[{"playerID":1,"score":3}]}]}]}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"error":"Invalid archive"}`,
+		},
 		"Delete an existing game": {
 			method:             http.MethodDelete,
 			endpoint:           "/games/1",
@@ -21,6 +21,63 @@ func TestPlayers(t *testing.T) {
 				executeSQLFile(t, db, "./test_data/games_setup_with_team.sql")
 			},
 		},
+		"Create game player": {
+			method:             "POST",
+			endpoint:           "/games/1/players",
+			requestBody:        `{"name":"Player 1"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       `{"player": {"id":1,"name":"Player 1"}}`,
+			expectedHeaders:    map[string]string{"Location": "/games/1/players/1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup.sql")
+
+				if _, err := db.ExecContext(t.Context(), "UPDATE games SET game_mode = 'individual', team_size = 1 WHERE id = 1"); err != nil {
+					t.Fatalf("Failed to switch game mode: %v", err)
+				}
+			},
+		},
+		"Create game player in a team game": {
+			method:             "POST",
+			endpoint:           "/games/1/players",
+			requestBody:        `{"name":"Player 1"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Not supported in the game mode"}`,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup.sql")
+			},
+		},
+		"Update game player": {
+			method:             "PUT",
+			endpoint:           "/games/1/players/1",
+			requestBody:        `{"name":"Player 1 Updated"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"player": {"id":1,"name":"Player 1 Updated"}}`,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup.sql")
+
+				if _, err := db.ExecContext(t.Context(), `UPDATE games SET game_mode = 'individual', team_size = 1 WHERE id = 1;
+INSERT INTO players (id, player_name, game_id) VALUES (1, 'Player 1', 1)`); err != nil {
+					t.Fatalf("Failed to prepare game player: %v", err)
+				}
+			},
+		},
+		"Delete game player not the owner": {
+			method:             "DELETE",
+			endpoint:           "/games/1/players/1",
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-2"},
+			expectedStatusCode: http.StatusForbidden,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup.sql")
+
+				if _, err := db.ExecContext(t.Context(), `UPDATE games SET game_mode = 'individual', team_size = 1 WHERE id = 1;
+INSERT INTO players (id, player_name, game_id) VALUES (1, 'Player 1', 1)`); err != nil {
+					t.Fatalf("Failed to prepare game player: %v", err)
+				}
+			},
+		},
 		"Create player invalid body": {
 			method:             "POST",
 			endpoint:           "/games/1/teams/1/players",
@@ -91,6 +148,32 @@ func TestPlayers(t *testing.T) {
 				executeSQLFile(t, db, "./test_data/games_setup_with_team_player.sql")
 			},
 		},
+		"Delete player keeps score history": {
+			method:             "DELETE",
+			endpoint:           "/games/1/teams/1/players/1",
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusNoContent,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_team_player.sql")
+
+				if _, err := db.ExecContext(t.Context(), `INSERT INTO score_history (game_id, round_number, table_number, player_id, player_name, new_score, owner_sub)
+VALUES (1, 1, 1, 1, 'Player 1', 3, 'sub-1')`); err != nil {
+					t.Fatalf("Failed to insert score history: %v", err)
+				}
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var count int
+				err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM score_history WHERE player_id IS NULL AND player_name = 'Player 1'").Scan(&count)
+				if err != nil {
+					t.Fatalf("failed to query score history: %v", err)
+				}
+				if count != 1 {
+					t.Errorf("the score history of a deleted player must be kept, got count %d", count)
+				}
+			},
+		},
 		"Delete player not found": {
 			method:             "DELETE",
 			endpoint:           "/games/1/teams/1/players/1",
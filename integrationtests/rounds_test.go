@@ -0,0 +1,425 @@
+package integrationtests
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/assert"
+)
+
+func assertRoundStatus(t *testing.T, db *sql.DB, roundID int, expected string) {
+	t.Helper()
+
+	var status string
+
+	if err := db.QueryRowContext(t.Context(), "SELECT status FROM rounds WHERE id = $1", roundID).Scan(&status); err != nil {
+		t.Fatalf("failed to query round status: %v", err)
+	}
+
+	assert.Equal(t, expected, status)
+}
+
+func TestRounds(t *testing.T) {
+	startGame := func(db *sql.DB) {
+		executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+
+		if _, err := db.ExecContext(t.Context(), "UPDATE games SET status = 'in_progress' WHERE id = 1"); err != nil {
+			t.Fatalf("failed to start game: %v", err)
+		}
+	}
+
+	tests := map[string]testCase{
+		"Print seating plan": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/rounds/1/seating-plan",
+			expectedStatusCode: http.StatusOK,
+			expectedHeaders:    map[string]string{"Content-Type": "application/pdf", "Content-Disposition": `inline; filename="game-1-round-1-seating-plan.pdf"`},
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"Print score sheets": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/rounds/2/score-sheets",
+			expectedStatusCode: http.StatusOK,
+			expectedHeaders:    map[string]string{"Content-Type": "application/pdf", "Content-Disposition": `inline; filename="game-1-round-2-score-sheets.pdf"`},
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"Print score sheets round not found": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/rounds/3/score-sheets",
+			expectedStatusCode: http.StatusNotFound,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"Print seating plan not owner": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/rounds/1/seating-plan",
+			expectedStatusCode: http.StatusForbidden,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-2"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"Start round": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/start",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"round":{"id":1,"gameID":1,"roundNumber":1,"status":"in_progress"}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup:              startGame,
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+				assertRoundStatus(t, db, 1, "in_progress")
+			},
+		},
+		"Start round of game not in progress": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/start",
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Game is not in progress"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+				assertRoundStatus(t, db, 1, "setup")
+			},
+		},
+		"Start round before previous round is completed": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/2/start",
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Previous round is not completed"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup:              startGame,
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+				assertRoundStatus(t, db, 2, "setup")
+			},
+		},
+		"Start round after previous round is completed": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/2/start",
+			expectedStatusCode: http.StatusOK,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				startGame(db)
+
+				if _, err := db.ExecContext(t.Context(), "UPDATE rounds SET status = 'completed' WHERE id = 1"); err != nil {
+					t.Fatalf("failed to complete round: %v", err)
+				}
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+				assertRoundStatus(t, db, 2, "in_progress")
+			},
+		},
+		"Start round while another round is in progress": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/2/start",
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Another round is already in progress"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				startGame(db)
+
+				if _, err := db.ExecContext(t.Context(), "UPDATE rounds SET status = 'in_progress' WHERE id = 1"); err != nil {
+					t.Fatalf("failed to start round: %v", err)
+				}
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+				assertRoundStatus(t, db, 2, "setup")
+			},
+		},
+		"Start completed round": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/start",
+			expectedStatusCode: http.StatusConflict,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Start round not owner": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/start",
+			expectedStatusCode: http.StatusForbidden,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-2"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"Start round not found": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/3/start",
+			expectedStatusCode: http.StatusNotFound,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"Draw next swiss round": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/next",
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       `{"round":{"id":2,"gameID":1,"roundNumber":2,"status":"setup"}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_swiss.sql")
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var players []int
+
+				rows, err := db.QueryContext(t.Context(), `SELECT tp.player_id FROM table_players tp
+					JOIN game_tables gt ON gt.id = tp.game_table_id
+					WHERE gt.round_id = 2 AND gt.table_number = 1 ORDER BY tp.player_id`)
+				if err != nil {
+					t.Fatalf("failed to query table players: %v", err)
+				}
+
+				defer rows.Close()
+
+				for rows.Next() {
+					var playerID int
+					if err := rows.Scan(&playerID); err != nil {
+						t.Fatalf("failed to scan table player: %v", err)
+					}
+
+					players = append(players, playerID)
+				}
+
+				assert.Equal(t, []int{1, 3}, players, "the two leading players share the first table")
+			},
+		},
+		"Draw next swiss round while previous round is open": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/next",
+			expectedStatusCode: http.StatusConflict,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_swiss.sql")
+
+				if _, err := db.ExecContext(t.Context(), "UPDATE rounds SET status = 'in_progress' WHERE id = 1"); err != nil {
+					t.Fatalf("failed to reopen round: %v", err)
+				}
+			},
+		},
+		"Draw next round of a random game": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/next",
+			expectedStatusCode: http.StatusConflict,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Close round": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/close",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"round":{"id":1,"gameID":1,"roundNumber":1,"status":"completed"}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_assigned_scores_entered.sql")
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+				assertRoundStatus(t, db, 1, "completed")
+			},
+		},
+		"Close round with missing scores": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/close",
+			expectedStatusCode: http.StatusConflict,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_assigned.sql")
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+				assertRoundStatus(t, db, 1, "in_progress")
+			},
+		},
+		"Close round not in progress": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/close",
+			expectedStatusCode: http.StatusConflict,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"Reassign round": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/2/reassign",
+			requestBody:        `{"seed":42}`,
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"round":{"id":2,"gameID":1,"roundNumber":2,"status":"setup"}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+
+				if _, err := db.ExecContext(t.Context(), "SELECT SETVAL('game_tables_id_seq', (SELECT MAX(id) FROM game_tables))"); err != nil {
+					t.Fatalf("Failed to prepare table sequence: %v", err)
+				}
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var firstRoundSeats, secondRoundSeats, oldTables, teammatesTogether int
+
+				if err := db.QueryRowContext(t.Context(), `SELECT COUNT(*) FROM table_players WHERE game_table_id BETWEEN 1 AND 8`).Scan(&firstRoundSeats); err != nil {
+					t.Fatalf("failed to count seats: %v", err)
+				}
+
+				if err := db.QueryRowContext(t.Context(), `SELECT COUNT(*) FROM table_players tp
+					JOIN game_tables gt ON gt.id = tp.game_table_id WHERE gt.round_id = 2`).Scan(&secondRoundSeats); err != nil {
+					t.Fatalf("failed to count seats: %v", err)
+				}
+
+				if err := db.QueryRowContext(t.Context(), `SELECT COUNT(*) FROM game_tables WHERE id BETWEEN 9 AND 16`).Scan(&oldTables); err != nil {
+					t.Fatalf("failed to count tables: %v", err)
+				}
+
+				if err := db.QueryRowContext(t.Context(), `SELECT COUNT(*) FROM table_players a
+					JOIN table_players b ON a.game_table_id = b.game_table_id AND a.player_id < b.player_id
+					JOIN players pa ON pa.id = a.player_id
+					JOIN players pb ON pb.id = b.player_id
+					WHERE pa.team_id = pb.team_id`).Scan(&teammatesTogether); err != nil {
+					t.Fatalf("failed to count teammates: %v", err)
+				}
+
+				assert.Equal(t, 32, firstRoundSeats, "round 1 stays untouched")
+				assert.Equal(t, 32, secondRoundSeats)
+				assert.Equal(t, 0, oldTables, "the tables of round 2 are replaced")
+				assert.Equal(t, 0, teammatesTogether)
+			},
+		},
+		"Reassign round keeps substitutions": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/2/reassign",
+			requestBody:        `{"seed":42}`,
+			expectedStatusCode: http.StatusOK,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+
+				if _, err := db.ExecContext(t.Context(), `SELECT SETVAL('game_tables_id_seq', (SELECT MAX(id) FROM game_tables));
+INSERT INTO players (id, player_name, team_id, substitute) VALUES (33, 'Player 33', 1, TRUE);
+UPDATE table_players SET player_id = 33 WHERE player_id = 1 AND game_table_id BETWEEN 9 AND 16;
+INSERT INTO substitutions (round_id, game_table_id, player_id, substitute_id)
+SELECT 2, game_table_id, 1, 33 FROM table_players WHERE player_id = 33`); err != nil {
+					t.Fatalf("Failed to substitute player: %v", err)
+				}
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var originalSeats, substituteSeats, substitutions int
+
+				if err := db.QueryRowContext(t.Context(), `SELECT COUNT(*) FROM table_players tp
+					JOIN game_tables gt ON gt.id = tp.game_table_id WHERE gt.round_id = 2 AND tp.player_id = 1`).Scan(&originalSeats); err != nil {
+					t.Fatalf("failed to count seats: %v", err)
+				}
+
+				if err := db.QueryRowContext(t.Context(), `SELECT COUNT(*) FROM table_players tp
+					JOIN game_tables gt ON gt.id = tp.game_table_id WHERE gt.round_id = 2 AND tp.player_id = 33`).Scan(&substituteSeats); err != nil {
+					t.Fatalf("failed to count seats: %v", err)
+				}
+
+				if err := db.QueryRowContext(t.Context(), `SELECT COUNT(*) FROM substitutions s
+					JOIN table_players tp ON tp.game_table_id = s.game_table_id AND tp.player_id = s.substitute_id
+					WHERE s.round_id = 2 AND s.player_id = 1 AND s.substitute_id = 33`).Scan(&substitutions); err != nil {
+					t.Fatalf("failed to count substitutions: %v", err)
+				}
+
+				assert.Equal(t, 0, originalSeats, "the substituted player stays out of the round")
+				assert.Equal(t, 1, substituteSeats)
+				assert.Equal(t, 1, substitutions, "the substitution is recorded at the new table of the substitute")
+			},
+		},
+		"Reassign round with scores": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/reassign",
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Round already has scores"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_assigned_with_scores.sql")
+			},
+		},
+		"Reassign completed round": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/reassign",
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Round is already completed"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_swiss.sql")
+			},
+		},
+		"Reassign round not owner": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/2/reassign",
+			expectedStatusCode: http.StatusForbidden,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-2"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"Reassign round not found": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/5/reassign",
+			expectedStatusCode: http.StatusNotFound,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"Close round invalid roundNumber": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/invalid/close",
+			expectedStatusCode: http.StatusBadRequest,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+		},
+	}
+
+	dbConn, teardownDatabase := setupTestDatabase(t)
+	defer teardownDatabase()
+
+	db, err := sql.Open("pgx", dbConn)
+	if err != nil {
+		t.Fatalf("Failed to open database connection: %v", err)
+	}
+
+	defer db.Close()
+
+	runGooseUp(t, db)
+
+	server, teardown := setupTestServer(t)
+	defer teardown(server)
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup(db)
+			}
+
+			defer executeSQLFile(t, db, "./test_data/cleanup.sql")
+			newTestRequest(t, tc, server, db)
+		})
+	}
+}
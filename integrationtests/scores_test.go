@@ -21,6 +21,14 @@ func TestScores(t *testing.T) {
 			setup: func(db *sql.DB) {
 				executeSQLFile(t, db, "./test_data/games_setup_assigned.sql")
 			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+				var count int
+				if err := db.QueryRowContext(t.Context(), "SELECT count(*) FROM score_history WHERE table_id = 1 AND old_score IS NULL AND owner_sub = 'sub-1'").Scan(&count); err != nil {
+					t.Fatalf("failed to query score history: %v", err)
+				}
+				assert.Equal(t, 4, count, "every new score is recorded")
+			},
 		},
 		"Update existing score for game": {
 			method:             "PUT",
@@ -32,6 +40,105 @@ func TestScores(t *testing.T) {
 			setup: func(db *sql.DB) {
 				executeSQLFile(t, db, "./test_data/games_setup_assigned_with_scores.sql")
 			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+				var count int
+				if err := db.QueryRowContext(t.Context(), "SELECT count(*) FROM score_history WHERE game_id = 1 AND round_number = 1 AND table_number = 1").Scan(&count); err != nil {
+					t.Fatalf("failed to query score history: %v", err)
+				}
+				assert.Equal(t, 4, count, "every accepted score is recorded")
+
+				var oldScore, newScore int
+				if err := db.QueryRowContext(t.Context(), "SELECT old_score, new_score FROM score_history WHERE player_id = 1").Scan(&oldScore, &newScore); err != nil {
+					t.Fatalf("failed to query score history: %v", err)
+				}
+				assert.Equal(t, []int{5, 6}, []int{oldScore, newScore})
+			},
+		},
+		"Correct score of completed round": {
+			method:             "PUT",
+			endpoint:           "/games/1/rounds/1/tables/1/scores",
+			expectedStatusCode: http.StatusOK,
+			requestBody:        `{"scores": [{"playerID":1,"score":4},{"playerID":3,"score":5}], "reason": "Typo"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+				var reason string
+				if err := db.QueryRowContext(t.Context(), "SELECT reason FROM score_history WHERE player_id = 1 AND old_score = 3 AND new_score = 4").Scan(&reason); err != nil {
+					t.Fatalf("failed to query score history: %v", err)
+				}
+				assert.Equal(t, "Typo", reason)
+			},
+		},
+		"Correct score of completed round with unchanged value": {
+			method:             "PUT",
+			endpoint:           "/games/1/rounds/1/tables/1/scores",
+			expectedStatusCode: http.StatusOK,
+			requestBody:        `{"scores": [{"playerID":1,"score":3},{"playerID":3,"score":5}], "reason": "Checked against score sheet"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+				var count int
+				if err := db.QueryRowContext(t.Context(), "SELECT count(*) FROM score_history WHERE reason = 'Checked against score sheet' AND old_score = new_score").Scan(&count); err != nil {
+					t.Fatalf("failed to query score history: %v", err)
+				}
+				assert.Equal(t, 2, count, "corrections are recorded even if the score does not change")
+			},
+		},
+		"Correct score of completed round without reason": {
+			method:             "PUT",
+			endpoint:           "/games/1/rounds/1/tables/1/scores",
+			expectedStatusCode: http.StatusBadRequest,
+			requestBody:        `{"scores": [{"playerID":1,"score":4},{"playerID":3,"score":5}], "reason": " "}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+				var score int
+				if err := db.QueryRowContext(t.Context(), "SELECT score FROM scores WHERE player_id = 1 AND table_id = 1").Scan(&score); err != nil {
+					t.Fatalf("failed to query scores: %v", err)
+				}
+				assert.Equal(t, 3, score, "the score must not change without a reason")
+			},
+		},
+		"Get score history": {
+			method:             "GET",
+			endpoint:           "/games/1/rounds/1/tables/1/scores/history",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       readContentFromFile(t, "./test_data/score_history.json"),
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+				executeSQLFile(t, db, "./test_data/score_history.sql")
+			},
+		},
+		"Get score history not game owner": {
+			method:             "GET",
+			endpoint:           "/games/1/rounds/1/tables/1/scores/history",
+			expectedStatusCode: http.StatusNotFound,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-2"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+				executeSQLFile(t, db, "./test_data/score_history.sql")
+			},
+		},
+		"Update score round not in progress": {
+			method:             "PUT",
+			endpoint:           "/games/1/rounds/1/tables/1/scores",
+			expectedStatusCode: http.StatusConflict,
+			requestBody:        `{"scores": [{"playerID":1,"score":6},{"playerID":5,"score":3},{"playerID":9,"score":2},{"playerID":13,"score":1}]}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
 		},
 		"Update score not game owner": {
 			method:             "PUT",
@@ -103,6 +210,20 @@ func TestScores(t *testing.T) {
 				executeSQLFile(t, db, "./test_data/games_setup_assigned.sql")
 			},
 		},
+		"Reject scores for a table seating more players than its capacity": {
+			method:             "PUT",
+			endpoint:           "/games/1/rounds/1/tables/1/scores",
+			expectedStatusCode: http.StatusBadRequest,
+			requestBody:        `{"scores": [{"playerID":1,"score":6},{"playerID":5,"score":3},{"playerID":9,"score":2},{"playerID":13,"score":1}]}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_assigned.sql")
+
+				if _, err := db.ExecContext(t.Context(), "UPDATE game_tables SET capacity = 3 WHERE id = 1"); err != nil {
+					t.Fatalf("Failed to prepare table: %v", err)
+				}
+			},
+		},
 		"Reject score for player not seated at the table (IDOR)": {
 			method:             "PUT",
 			endpoint:           "/games/1/rounds/1/tables/1/scores",
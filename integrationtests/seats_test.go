@@ -0,0 +1,229 @@
+package integrationtests
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/assert"
+)
+
+func assertSeatedAt(t *testing.T, db *sql.DB, playerID, roundID, expectedTableNumber int) {
+	t.Helper()
+
+	var tableNumber int
+
+	if err := db.QueryRowContext(t.Context(), `SELECT gt.table_number FROM table_players tp
+		JOIN game_tables gt ON gt.id = tp.game_table_id
+		WHERE tp.player_id = $1 AND gt.round_id = $2`, playerID, roundID).Scan(&tableNumber); err != nil {
+		t.Fatalf("failed to query seat: %v", err)
+	}
+
+	assert.Equal(t, expectedTableNumber, tableNumber)
+}
+
+func TestSeats(t *testing.T) {
+	tests := map[string]testCase{
+		"Swap players between tables": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/seats/swap",
+			requestBody:        `{"playerID":1,"otherPlayerID":17}`,
+			expectedStatusCode: http.StatusOK,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+				assertSeatedAt(t, db, 1, 1, 2)
+				assertSeatedAt(t, db, 17, 1, 1)
+				assertSeatedAt(t, db, 1, 2, 1)
+			},
+		},
+		"Swap players seating teammates together": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/seats/swap",
+			requestBody:        `{"playerID":1,"otherPlayerID":6}`,
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Teammates cannot share a table"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+				assertSeatedAt(t, db, 1, 1, 1)
+			},
+		},
+		"Swap players at the same table": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/seats/swap",
+			requestBody:        `{"playerID":1,"otherPlayerID":5}`,
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Players already sit at the same table"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"Swap players at a scored table": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/seats/swap",
+			requestBody:        `{"playerID":1,"otherPlayerID":17}`,
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Table already has scores"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+
+				if _, err := db.ExecContext(t.Context(), "INSERT INTO scores (player_id, table_id, score) VALUES (17, 2, 5)"); err != nil {
+					t.Fatalf("Failed to enter score: %v", err)
+				}
+			},
+		},
+		"Swap player with themselves": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/seats/swap",
+			requestBody:        `{"playerID":1,"otherPlayerID":1}`,
+			expectedStatusCode: http.StatusBadRequest,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"Swap players in a completed round": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/seats/swap",
+			requestBody:        `{"playerID":1,"otherPlayerID":17}`,
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Round is already completed"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+
+				if _, err := db.ExecContext(t.Context(), "UPDATE rounds SET status = 'completed' WHERE id = 1"); err != nil {
+					t.Fatalf("Failed to complete round: %v", err)
+				}
+			},
+		},
+		"Swap players not game owner": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/seats/swap",
+			requestBody:        `{"playerID":1,"otherPlayerID":17}`,
+			expectedStatusCode: http.StatusForbidden,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-2"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"Move player to a free seat": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/seats/move",
+			requestBody:        `{"playerID":1,"tableNumber":2}`,
+			expectedStatusCode: http.StatusOK,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+
+				if _, err := db.ExecContext(t.Context(), "UPDATE game_tables SET capacity = 5 WHERE id = 2"); err != nil {
+					t.Fatalf("Failed to add a seat: %v", err)
+				}
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+				assertSeatedAt(t, db, 1, 1, 2)
+			},
+		},
+		"Move player sitting out to a free seat": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/seats/move",
+			requestBody:        `{"playerID":33,"tableNumber":2}`,
+			expectedStatusCode: http.StatusOK,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+
+				if _, err := db.ExecContext(t.Context(), `INSERT INTO players (id, player_name, team_id) VALUES (33, 'Player 33', 1);
+INSERT INTO round_byes (round_id, player_id) VALUES (1, 33);
+UPDATE game_tables SET capacity = 5 WHERE id = 2`); err != nil {
+					t.Fatalf("Failed to prepare bye: %v", err)
+				}
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+				assertSeatedAt(t, db, 33, 1, 2)
+
+				var byes int
+
+				if err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM round_byes WHERE player_id = 33").Scan(&byes); err != nil {
+					t.Fatalf("failed to count byes: %v", err)
+				}
+
+				assert.Equal(t, 0, byes)
+			},
+		},
+		"Move player to a full table": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/seats/move",
+			requestBody:        `{"playerID":1,"tableNumber":2}`,
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Table has no free seat"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"Move player to a table with a teammate": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/seats/move",
+			requestBody:        `{"playerID":1,"tableNumber":3}`,
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Teammates cannot share a table"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+
+				if _, err := db.ExecContext(t.Context(), "UPDATE game_tables SET capacity = 5 WHERE id = 3"); err != nil {
+					t.Fatalf("Failed to add a seat: %v", err)
+				}
+			},
+		},
+		"Move player to an unknown table": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/seats/move",
+			requestBody:        `{"playerID":1,"tableNumber":9}`,
+			expectedStatusCode: http.StatusNotFound,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+	}
+
+	dbConn, teardownDatabase := setupTestDatabase(t)
+	defer teardownDatabase()
+
+	db, err := sql.Open("pgx", dbConn)
+	if err != nil {
+		t.Fatalf("Failed to open database connection: %v", err)
+	}
+
+	defer db.Close()
+
+	runGooseUp(t, db)
+
+	server, teardown := setupTestServer(t)
+	defer teardown(server)
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup(db)
+			}
+
+			defer executeSQLFile(t, db, "./test_data/cleanup.sql")
+			newTestRequest(t, tc, server, db)
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package integrationtests
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShares(t *testing.T) {
+	setupShared := func(db *sql.DB) {
+		executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+		executeSQLFile(t, db, "./test_data/game_shares.sql")
+	}
+
+	tests := map[string]testCase{
+		"Get share": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/share",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"share":{"token":"XK4H2MZQ7RT5WB3NJ6PLC2VDEY","abbreviateNames":true,"createdAt":"2026-01-01T12:00:00Z"}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup:              setupShared,
+		},
+		"Get share of game not shared": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/share",
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       `{"error":"Share not found"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Get share not owner": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/share",
+			expectedStatusCode: http.StatusForbidden,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-2"},
+			setup:              setupShared,
+		},
+		"Create share replaces token": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/share",
+			requestBody:        `{"abbreviateNames":false}`,
+			expectedStatusCode: http.StatusCreated,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup:              setupShared,
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var (
+					token           string
+					abbreviateNames bool
+				)
+
+				if err := db.QueryRowContext(t.Context(), "SELECT token, abbreviate_names FROM game_shares WHERE game_id = 1").Scan(&token, &abbreviateNames); err != nil {
+					t.Fatalf("Failed to query share: %v", err)
+				}
+
+				assert.NotEqual(t, "XK4H2MZQ7RT5WB3NJ6PLC2VDEY", token)
+				assert.False(t, abbreviateNames)
+			},
+		},
+		"Create share without body": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/share",
+			expectedStatusCode: http.StatusCreated,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var shares int
+
+				if err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM game_shares WHERE game_id = 1 AND NOT abbreviate_names").Scan(&shares); err != nil {
+					t.Fatalf("Failed to query shares: %v", err)
+				}
+
+				assert.Equal(t, 1, shares)
+			},
+		},
+		"Create share not owner": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/share",
+			expectedStatusCode: http.StatusForbidden,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-2"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Revoke share": {
+			method:             http.MethodDelete,
+			endpoint:           "/games/1/share",
+			expectedStatusCode: http.StatusNoContent,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup:              setupShared,
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var shares int
+
+				if err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM game_shares").Scan(&shares); err != nil {
+					t.Fatalf("Failed to query shares: %v", err)
+				}
+
+				assert.Equal(t, 0, shares)
+			},
+		},
+		"Revoke share of game not shared": {
+			method:             http.MethodDelete,
+			endpoint:           "/games/1/share",
+			expectedStatusCode: http.StatusNotFound,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Get shared game": {
+			method:             http.MethodGet,
+			endpoint:           "/public/games/XK4H2MZQ7RT5WB3NJ6PLC2VDEY",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"game":{"name":"Game 1","status":"in_progress","numberOfRounds":2}}`,
+			expectedHeaders:    map[string]string{"Cache-Control": "no-store"},
+			setup:              setupShared,
+		},
+		"Get shared standings": {
+			method:             http.MethodGet,
+			endpoint:           "/public/games/XK4H2MZQ7RT5WB3NJ6PLC2VDEY/standings",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       readContentFromFile(t, "./test_data/public_standings.json"),
+			setup:              setupShared,
+		},
+		"Get shared rounds": {
+			method:             http.MethodGet,
+			endpoint:           "/public/games/XK4H2MZQ7RT5WB3NJ6PLC2VDEY/rounds",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       readContentFromFile(t, "./test_data/public_rounds.json"),
+			setup:              setupShared,
+		},
+		"Get shared game with unknown token": {
+			method:             http.MethodGet,
+			endpoint:           "/public/games/UNKNOWN",
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       `{"error":"Share not found"}`,
+			setup:              setupShared,
+		},
+		"Get shared standings of game not shared": {
+			method:             http.MethodGet,
+			endpoint:           "/public/games/XK4H2MZQ7RT5WB3NJ6PLC2VDEY/standings",
+			expectedStatusCode: http.StatusNotFound,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+	}
+
+	dbConn, teardownDatabase := setupTestDatabase(t)
+	defer teardownDatabase()
+
+	db, err := sql.Open("pgx", dbConn)
+	if err != nil {
+		t.Fatalf("Failed to open database connection: %v", err)
+	}
+
+	defer db.Close()
+
+	runGooseUp(t, db)
+
+	server, teardown := setupTestServer(t)
+	defer teardown(server)
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup(db)
+			}
+
+			defer executeSQLFile(t, db, "./test_data/cleanup.sql")
+			newTestRequest(t, tc, server, db)
+		})
+	}
+}
@@ -0,0 +1,354 @@
+package integrationtests
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStages(t *testing.T) {
+	withStages := func(db *sql.DB) {
+		executeSQLFile(t, db, "./test_data/games_setup_assignable.sql")
+
+		if _, err := db.ExecContext(t.Context(), `INSERT INTO stages (id, game_id, position, stage_name, number_of_rounds, group_count, qualification, qualifiers, carry_over)
+VALUES (1, 1, 1, 'Preliminaries', 1, 1, 'all', 0, 'none'),
+(2, 1, 2, 'Final stage', 1, 2, 'top_overall', 8, 'full')`); err != nil {
+			t.Fatalf("Failed to create stages: %v", err)
+		}
+	}
+
+	// completedFirstStage plays the rounds of the standings fixture as a completed first stage.
+	completedFirstStage := func(db *sql.DB) {
+		executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+
+		if _, err := db.ExecContext(t.Context(), `INSERT INTO stages (id, game_id, position, stage_name, number_of_rounds, qualification, qualifiers, carry_over, status)
+VALUES (1, 1, 1, 'Preliminaries', 2, 'all', 0, 'none', 'in_progress'),
+(2, 1, 2, 'Final stage', 1, 'top_overall', 2, 'full', 'pending');
+INSERT INTO stage_players (stage_id, player_id) VALUES (1, 1), (1, 2), (1, 3), (1, 4);
+INSERT INTO scores (id, player_id, table_id, score) VALUES (8, 3, 4, 0);
+UPDATE rounds SET status = 'completed', stage_id = 1;
+UPDATE game_tables SET stage_id = 1`); err != nil {
+			t.Fatalf("Failed to complete first stage: %v", err)
+		}
+	}
+
+	tests := map[string]testCase{
+		"Configure stages": {
+			method:   http.MethodPut,
+			endpoint: "/games/1/stages",
+			requestBody: `{"stages":[{"name":"Preliminaries","numberOfRounds":2,"qualification":"all"},
+{"name":"Final stage","pairingMode":"swiss","numberOfRounds":2,"groups":2,"qualification":"top_overall","qualifiers":8,"carryOver":"full"}]}`,
+			expectedStatusCode: http.StatusOK,
+			expectedBody: `{"stages":[
+{"id":1,"position":1,"name":"Preliminaries","pairingMode":"random","numberOfRounds":2,"groups":1,"qualification":"all","qualifiers":0,"carryOver":"none","status":"pending"},
+{"id":2,"position":2,"name":"Final stage","pairingMode":"swiss","numberOfRounds":2,"groups":2,"qualification":"top_overall","qualifiers":8,"carryOver":"full","status":"pending"}]}`,
+			requestHeaders: map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_assignable.sql")
+			},
+		},
+		"Configure stages with a later stage open to all players": {
+			method:             http.MethodPut,
+			endpoint:           "/games/1/stages",
+			requestBody:        `{"stages":[{"name":"Preliminaries","numberOfRounds":2,"qualification":"all"},{"name":"Final stage","numberOfRounds":1,"qualification":"all"}]}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"error":"Invalid stage configuration"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_assignable.sql")
+			},
+		},
+		"Configure stages after rounds have been drawn": {
+			method:             http.MethodPut,
+			endpoint:           "/games/1/stages",
+			requestBody:        `{"stages":[{"name":"Preliminaries","numberOfRounds":2,"qualification":"all"}]}`,
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Stages cannot be changed after rounds have been drawn"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Configure stages not game owner": {
+			method:             http.MethodPut,
+			endpoint:           "/games/1/stages",
+			requestBody:        `{"stages":[]}`,
+			expectedStatusCode: http.StatusForbidden,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-2"},
+			setup:              withStages,
+		},
+		"Get stages": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/stages",
+			expectedStatusCode: http.StatusOK,
+			expectedBody: `{"stages":[
+{"id":1,"position":1,"name":"Preliminaries","pairingMode":"random","numberOfRounds":1,"groups":1,"qualification":"all","qualifiers":0,"carryOver":"none","status":"pending"},
+{"id":2,"position":2,"name":"Final stage","pairingMode":"random","numberOfRounds":1,"groups":2,"qualification":"top_overall","qualifiers":8,"carryOver":"full","status":"pending"}]}`,
+			requestHeaders: map[string]string{"Authorization": "Bearer sub-1"},
+			setup:          withStages,
+		},
+		"Setup a game played in stages": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/setup",
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Game is played in stages"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup:              withStages,
+		},
+		"Advance to the first stage": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/stages/advance",
+			requestBody:        `{"seed":42}`,
+			expectedStatusCode: http.StatusOK,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup:              withStages,
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var status string
+
+				var stagePlayers, rounds, tables int
+
+				if err := db.QueryRowContext(t.Context(), "SELECT status FROM stages WHERE id = 1").Scan(&status); err != nil {
+					t.Fatalf("Failed to query stage: %v", err)
+				}
+
+				if err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM stage_players WHERE stage_id = 1").Scan(&stagePlayers); err != nil {
+					t.Fatalf("Failed to count stage players: %v", err)
+				}
+
+				if err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM rounds WHERE stage_id = 1 AND round_number = 1").Scan(&rounds); err != nil {
+					t.Fatalf("Failed to count rounds: %v", err)
+				}
+
+				if err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM game_tables WHERE stage_id = 1").Scan(&tables); err != nil {
+					t.Fatalf("Failed to count tables: %v", err)
+				}
+
+				assert.Equal(t, "in_progress", status)
+				assert.Equal(t, 16, stagePlayers)
+				assert.Equal(t, 1, rounds)
+				assert.Equal(t, 4, tables)
+			},
+		},
+		"Advance with the current stage not completed": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/stages/advance",
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Current stage is not completed"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				completedFirstStage(db)
+
+				if _, err := db.ExecContext(t.Context(), "UPDATE rounds SET status = 'in_progress' WHERE round_number = 2"); err != nil {
+					t.Fatalf("Failed to reopen round: %v", err)
+				}
+			},
+		},
+		"Advance without stages": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/stages/advance",
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"No further stage"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_assignable.sql")
+			},
+		},
+		"Advance to a qualifying stage": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/stages/advance",
+			requestBody:        `{"seed":42}`,
+			expectedStatusCode: http.StatusOK,
+			expectedBody: `{"stage":{"id":2,"position":2,"name":"Final stage","pairingMode":"random","numberOfRounds":1,"groups":1,"qualification":"top_overall","qualifiers":2,"carryOver":"full","status":"in_progress",
+"players":[{"playerID":2,"group":1,"carriedScore":8},{"playerID":1,"group":1,"carriedScore":7}]}}`,
+			requestHeaders: map[string]string{"Authorization": "Bearer sub-1"},
+			setup:          completedFirstStage,
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var status string
+
+				var players int
+
+				if err := db.QueryRowContext(t.Context(), "SELECT status FROM stages WHERE id = 1").Scan(&status); err != nil {
+					t.Fatalf("Failed to query stage: %v", err)
+				}
+
+				if err := db.QueryRowContext(t.Context(), `SELECT COUNT(*) FROM table_players
+JOIN game_tables ON game_tables.id = table_players.game_table_id
+JOIN rounds ON rounds.id = game_tables.round_id
+WHERE rounds.round_number = 3 AND rounds.stage_id = 2 AND table_players.player_id IN (1, 2)`).Scan(&players); err != nil {
+					t.Fatalf("Failed to count seated players: %v", err)
+				}
+
+				assert.Equal(t, "completed", status)
+				assert.Equal(t, 2, players)
+			},
+		},
+		"Get stage standings": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/stages/2/standings",
+			expectedStatusCode: http.StatusOK,
+			expectedBody: `{"standings":{"gameID":1,"afterRound":3,"complete":true,
+"teams":[{"rank":1,"teamID":1,"name":"Team 1","totalScore":21,"gapToLeader":0,"rounds":[{"roundNumber":3,"score":6}],"complete":true,"byes":0,"shortTables":0}],
+"players":[
+{"rank":1,"playerID":1,"name":"Player 1","teamID":1,"totalScore":12,"gapToLeader":0,"rounds":[{"roundNumber":3,"score":5}],"complete":true,"byes":0,"shortTables":0,"substitutedOut":0,"substitutedIn":0},
+{"rank":2,"playerID":2,"name":"Player 2","teamID":1,"totalScore":9,"gapToLeader":3,"rounds":[{"roundNumber":3,"score":1}],"complete":true,"byes":0,"shortTables":0,"substitutedOut":0,"substitutedIn":0}]}}`,
+			requestHeaders: map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				completedFirstStage(db)
+
+				if _, err := db.ExecContext(t.Context(), `UPDATE stages SET status = 'completed' WHERE id = 1;
+UPDATE stages SET status = 'in_progress' WHERE id = 2;
+INSERT INTO stage_players (stage_id, player_id, carried_score) VALUES (2, 2, 8), (2, 1, 7);
+INSERT INTO rounds (id, round_number, game_id, stage_id, status) VALUES (3, 3, 1, 2, 'completed');
+INSERT INTO game_tables (id, table_number, round_id, stage_id, capacity) VALUES (5, 1, 3, 2, 2);
+INSERT INTO table_players (game_table_id, player_id) VALUES (5, 1), (5, 2);
+INSERT INTO scores (id, player_id, table_id, score) VALUES (9, 1, 5, 5), (10, 2, 5, 1)`); err != nil {
+					t.Fatalf("Failed to play second stage: %v", err)
+				}
+			},
+		},
+		"Get standings of an unknown stage": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/stages/3/standings",
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       `{"error":"Stage not found"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup:              withStages,
+		},
+	}
+
+	dbConn, teardownDatabase := setupTestDatabase(t)
+	defer teardownDatabase()
+
+	db, err := sql.Open("pgx", dbConn)
+	if err != nil {
+		t.Fatalf("Failed to open database connection: %v", err)
+	}
+
+	defer db.Close()
+
+	runGooseUp(t, db)
+
+	server, teardown := setupTestServer(t)
+	defer teardown(server)
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup(db)
+			}
+
+			defer executeSQLFile(t, db, "./test_data/cleanup.sql")
+			newTestRequest(t, tc, server, db)
+		})
+	}
+}
+
+func TestStagesWithTableLayout(t *testing.T) {
+	dbConn, teardownDatabase := setupTestDatabase(t)
+	defer teardownDatabase()
+
+	db, err := sql.Open("pgx", dbConn)
+	if err != nil {
+		t.Fatalf("Failed to open database connection: %v", err)
+	}
+
+	defer db.Close()
+
+	runGooseUp(t, db)
+
+	server, teardown := setupTestServer(t)
+	defer teardown(server)
+
+	// 18 players in two groups of nine, every group seated at a table of five and a table of four.
+	if _, err := db.ExecContext(t.Context(), `INSERT INTO games (id, game_name, team_size, table_size, number_of_rounds, status, game_mode, table_layout)
+VALUES (1, 'Game 1', 1, 4, 1, 'setup', 'individual', '5,4,5,4');
+INSERT INTO game_owners (game_id, owner_sub) VALUES (1, 'sub-1');
+INSERT INTO players (id, player_name, game_id) SELECT i, 'Player ' || i, 1 FROM generate_series(1, 18) AS i;
+INSERT INTO stages (id, game_id, position, stage_name, number_of_rounds, group_count, qualification, qualifiers, carry_over)
+VALUES (1, 1, 1, 'Preliminaries', 1, 2, 'all', 0, 'none')`); err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+
+	defer executeSQLFile(t, db, "./test_data/cleanup.sql")
+
+	t.Run("Advance to the first stage", func(t *testing.T) {
+		newTestRequest(t, testCase{
+			method:             http.MethodPost,
+			endpoint:           "/games/1/stages/advance",
+			requestBody:        `{"seed":42}`,
+			expectedStatusCode: http.StatusOK,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				rows, err := db.QueryContext(t.Context(), `SELECT game_tables.capacity, COUNT(*) FROM game_tables
+JOIN table_players ON table_players.game_table_id = game_tables.id
+GROUP BY game_tables.table_number, game_tables.capacity
+ORDER BY game_tables.table_number`)
+				if err != nil {
+					t.Fatalf("Failed to query tables: %v", err)
+				}
+				defer rows.Close()
+
+				var capacities, seated []int
+
+				for rows.Next() {
+					var capacity, players int
+					if err := rows.Scan(&capacity, &players); err != nil {
+						t.Fatalf("Failed to scan table: %v", err)
+					}
+
+					capacities = append(capacities, capacity)
+					seated = append(seated, players)
+				}
+
+				assert.Equal(t, []int{5, 4, 5, 4}, capacities, "every group is seated at its own tables of the layout")
+				assert.Equal(t, capacities, seated)
+			},
+		}, server, db)
+	})
+
+	if _, err := db.ExecContext(t.Context(), "UPDATE games SET status = 'in_progress'; UPDATE rounds SET status = 'in_progress'"); err != nil {
+		t.Fatalf("Failed to start round: %v", err)
+	}
+
+	for _, tableNumber := range []int{1, 3} {
+		t.Run(fmt.Sprintf("Enter scores at table %d", tableNumber), func(t *testing.T) {
+			rows, err := db.QueryContext(t.Context(), `SELECT table_players.player_id FROM table_players
+JOIN game_tables ON game_tables.id = table_players.game_table_id
+WHERE game_tables.table_number = $1`, tableNumber)
+			if err != nil {
+				t.Fatalf("Failed to query seats: %v", err)
+			}
+			defer rows.Close()
+
+			var scores []string
+
+			for rows.Next() {
+				var playerID int
+				if err := rows.Scan(&playerID); err != nil {
+					t.Fatalf("Failed to scan seat: %v", err)
+				}
+
+				scores = append(scores, fmt.Sprintf(`{"playerID":%d,"score":1}`, playerID))
+			}
+
+			newTestRequest(t, testCase{
+				method:             http.MethodPut,
+				endpoint:           fmt.Sprintf("/games/1/rounds/1/tables/%d/scores", tableNumber),
+				requestBody:        `{"scores":[` + strings.Join(scores, ",") + `]}`,
+				expectedStatusCode: http.StatusOK,
+				requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			}, server, db)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package integrationtests
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+func TestStandings(t *testing.T) {
+	tests := map[string]testCase{
+		"Get standings": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/standings?afterRound=2",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       readContentFromFile(t, "./test_data/standings.json"),
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Get standings defaults to last completed round": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/standings",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       readContentFromFile(t, "./test_data/standings_after_round_1.json"),
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Get standings after round": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/standings?afterRound=1",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       readContentFromFile(t, "./test_data/standings_after_round_1.json"),
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Get standings after unknown round": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/standings?afterRound=3",
+			expectedStatusCode: http.StatusNotFound,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Get standings invalid afterRound": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/standings?afterRound=0",
+			expectedStatusCode: http.StatusBadRequest,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Get standings before any round is drawn": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/standings",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"standings":{"gameID":1,"afterRound":0,"complete":true,"teams":[],"players":[]}}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup.sql")
+			},
+		},
+		"Export results as CSV": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/results",
+			expectedStatusCode: http.StatusOK,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1", "Accept": "text/csv"},
+			expectedHeaders:    map[string]string{"Content-Type": "text/csv; charset=utf-8", "Content-Disposition": `attachment; filename="results-1.csv"`},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Export results as XLSX": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/results",
+			expectedStatusCode: http.StatusOK,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1", "Accept": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet, text/csv;q=0.5"},
+			expectedHeaders:    map[string]string{"Content-Type": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", "Content-Disposition": `attachment; filename="results-1.xlsx"`},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Export results not acceptable": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/results",
+			expectedStatusCode: http.StatusNotAcceptable,
+			expectedBody:       `{"error":"Not acceptable"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1", "Accept": "application/pdf"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Export results not owner": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/results",
+			expectedStatusCode: http.StatusForbidden,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-2"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Get standings not owner": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/standings",
+			expectedStatusCode: http.StatusForbidden,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-2"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Get standings game not found": {
+			method:             http.MethodGet,
+			endpoint:           "/games/2/standings",
+			expectedStatusCode: http.StatusNotFound,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_standings.sql")
+			},
+		},
+		"Get standings invalid gameID": {
+			method:             http.MethodGet,
+			endpoint:           "/games/invalid/standings",
+			expectedStatusCode: http.StatusBadRequest,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+		},
+	}
+
+	dbConn, teardownDatabase := setupTestDatabase(t)
+	defer teardownDatabase()
+
+	db, err := sql.Open("pgx", dbConn)
+	if err != nil {
+		t.Fatalf("Failed to open database connection: %v", err)
+	}
+
+	defer db.Close()
+
+	runGooseUp(t, db)
+
+	server, teardown := setupTestServer(t)
+	defer teardown(server)
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup(db)
+			}
+
+			defer executeSQLFile(t, db, "./test_data/cleanup.sql")
+			newTestRequest(t, tc, server, db)
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package integrationtests
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubstitutions(t *testing.T) {
+	tests := map[string]testCase{
+		"Substitute a new player for the remaining rounds": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/substitutions",
+			requestBody:        `{"playerID":1,"substituteName":"Player 33","scope":"remaining"}`,
+			expectedStatusCode: http.StatusCreated,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+
+				if _, err := db.ExecContext(t.Context(), "SELECT setval('players_id_seq', 32)"); err != nil {
+					t.Fatalf("Failed to prepare player sequence: %v", err)
+				}
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var substitutions, seatedOriginal, seatedSubstitute int
+
+				var substitute bool
+
+				if err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM substitutions WHERE player_id = 1 AND substitute_id = 33").Scan(&substitutions); err != nil {
+					t.Fatalf("Failed to count substitutions: %v", err)
+				}
+
+				if err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM table_players WHERE player_id = 1").Scan(&seatedOriginal); err != nil {
+					t.Fatalf("Failed to count seats: %v", err)
+				}
+
+				if err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM table_players WHERE player_id = 33").Scan(&seatedSubstitute); err != nil {
+					t.Fatalf("Failed to count seats: %v", err)
+				}
+
+				if err := db.QueryRowContext(t.Context(), "SELECT substitute FROM players WHERE id = 33 AND team_id = 1").Scan(&substitute); err != nil {
+					t.Fatalf("Failed to query substitute: %v", err)
+				}
+
+				assert.Equal(t, 2, substitutions, "one substitution for each of the two rounds")
+				assert.Equal(t, 0, seatedOriginal)
+				assert.Equal(t, 2, seatedSubstitute)
+				assert.True(t, substitute)
+			},
+		},
+		"Substitute a teammate for one round": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/substitutions",
+			requestBody:        `{"playerID":1,"substituteID":33,"scope":"round"}`,
+			expectedStatusCode: http.StatusCreated,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+
+				if _, err := db.ExecContext(t.Context(), "INSERT INTO players (id, player_name, team_id) VALUES (33, 'Player 33', 1)"); err != nil {
+					t.Fatalf("Failed to create reserve player: %v", err)
+				}
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var roundOneTable, roundTwoSeats int
+
+				if err := db.QueryRowContext(t.Context(), "SELECT game_table_id FROM table_players WHERE player_id = 33").Scan(&roundOneTable); err != nil {
+					t.Fatalf("Failed to query seat: %v", err)
+				}
+
+				if err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM table_players WHERE player_id = 1 AND game_table_id > 8").Scan(&roundTwoSeats); err != nil {
+					t.Fatalf("Failed to count seats: %v", err)
+				}
+
+				assert.Equal(t, 1, roundOneTable, "the substitute takes the seat at table 1 of round 1")
+				assert.Equal(t, 1, roundTwoSeats, "the player keeps the seat in round 2")
+			},
+		},
+		"Substitute a player who already has a score": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/substitutions",
+			requestBody:        `{"playerID":1,"substituteName":"Player 33","scope":"round"}`,
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Player already has a score in the round"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_assigned_with_scores.sql")
+			},
+		},
+		"Substitute a seated teammate": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/substitutions",
+			requestBody:        `{"playerID":1,"substituteID":2,"scope":"round"}`,
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Substitute is not available"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"Substitute a player of another team": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/substitutions",
+			requestBody:        `{"playerID":1,"substituteID":5,"scope":"round"}`,
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Substitute is not available"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"Substitute in a completed round": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/substitutions",
+			requestBody:        `{"playerID":1,"substituteName":"Player 33","scope":"round"}`,
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Round is already completed"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+
+				if _, err := db.ExecContext(t.Context(), "UPDATE rounds SET status = 'completed' WHERE id = 1"); err != nil {
+					t.Fatalf("Failed to complete round: %v", err)
+				}
+			},
+		},
+		"Substitute for the remaining rounds of a swiss game": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/substitutions",
+			requestBody:        `{"playerID":1,"substituteName":"Player 33","scope":"remaining"}`,
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Substitutions for the remaining rounds are not supported with swiss pairing"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+
+				if _, err := db.ExecContext(t.Context(), "UPDATE games SET pairing_mode = 'swiss' WHERE id = 1"); err != nil {
+					t.Fatalf("Failed to switch pairing mode: %v", err)
+				}
+			},
+		},
+		"Substitute without a substitute": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/substitutions",
+			requestBody:        `{"playerID":1,"scope":"round"}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"error":"Invalid substitution request"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"Substitute not game owner": {
+			method:             http.MethodPost,
+			endpoint:           "/games/1/rounds/1/substitutions",
+			requestBody:        `{"playerID":1,"substituteName":"Player 33","scope":"round"}`,
+			expectedStatusCode: http.StatusForbidden,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-2"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"Get substitutions": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/substitutions",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"substitutions":[{"id":1,"roundNumber":1,"tableNumber":1,"playerID":1,"substituteID":33,"createdAt":"2025-01-01T19:00:00Z"}]}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+
+				if _, err := db.ExecContext(t.Context(), `INSERT INTO players (id, player_name, team_id, substitute) VALUES (33, 'Player 33', 1, TRUE);
+UPDATE table_players SET player_id = 33 WHERE game_table_id = 1 AND player_id = 1;
+INSERT INTO substitutions (id, round_id, game_table_id, player_id, substitute_id, created_at) VALUES (1, 1, 1, 1, 33, '2025-01-01T19:00:00Z')`); err != nil {
+					t.Fatalf("Failed to prepare substitution: %v", err)
+				}
+			},
+		},
+		"Get substitutions not game owner": {
+			method:             http.MethodGet,
+			endpoint:           "/games/1/substitutions",
+			expectedStatusCode: http.StatusForbidden,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-2"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+	}
+
+	dbConn, teardownDatabase := setupTestDatabase(t)
+	defer teardownDatabase()
+
+	db, err := sql.Open("pgx", dbConn)
+	if err != nil {
+		t.Fatalf("Failed to open database connection: %v", err)
+	}
+
+	defer db.Close()
+
+	runGooseUp(t, db)
+
+	server, teardown := setupTestServer(t)
+	defer teardown(server)
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup(db)
+			}
+
+			defer executeSQLFile(t, db, "./test_data/cleanup.sql")
+			newTestRequest(t, tc, server, db)
+		})
+	}
+}
@@ -212,6 +212,59 @@ func TestTables(t *testing.T) {
 				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
 			},
 		},
+		"update seat order": {
+			method:             "PUT",
+			endpoint:           "/games/1/rounds/1/tables/1/seats",
+			requestBody:        `{"seatOrder":[5,9,13,1]}`,
+			expectedStatusCode: http.StatusOK,
+			expectedBody: `{"table":{"id":1,"tableNumber":1,"roundID":1,"capacity":4,"players":[
+				{"id":5,"name":"Player 5","teamID":2},{"id":9,"name":"Player 9","teamID":3},
+				{"id":13,"name":"Player 13","teamID":4},{"id":1,"name":"Player 1","teamID":1}],"seatOrder":[5,9,13,1]}}`,
+
+			requestHeaders: map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"update seat order missing a player": {
+			method:             "PUT",
+			endpoint:           "/games/1/rounds/1/tables/1/seats",
+			requestBody:        `{"seatOrder":[5,9,13,13]}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"error":"Seat order must list every player of the table once"}`,
+
+			requestHeaders: map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
+		"update seat order of a completed round": {
+			method:             "PUT",
+			endpoint:           "/games/1/rounds/1/tables/1/seats",
+			requestBody:        `{"seatOrder":[5,9,13,1]}`,
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Round is already completed"}`,
+
+			requestHeaders: map[string]string{"Authorization": "Bearer sub-1"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+
+				if _, err := db.ExecContext(t.Context(), "UPDATE rounds SET status = 'completed' WHERE id = 1"); err != nil {
+					t.Fatalf("Failed to complete round: %v", err)
+				}
+			},
+		},
+		"update seat order not game owner": {
+			method:             "PUT",
+			endpoint:           "/games/1/rounds/1/tables/1/seats",
+			requestBody:        `{"seatOrder":[5,9,13,1]}`,
+			expectedStatusCode: http.StatusNotFound,
+
+			requestHeaders: map[string]string{"Authorization": "Bearer sub-2"},
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup_with_tables.sql")
+			},
+		},
 	}
 
 	dbConn, teardownDatabase := setupTestDatabase(t)
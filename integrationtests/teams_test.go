@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestTeams(t *testing.T) {
@@ -21,6 +22,21 @@ func TestTeams(t *testing.T) {
 				executeSQLFile(t, db, "./test_data/games_setup.sql")
 			},
 		},
+		"Create team in an individual game": {
+			method:             "POST",
+			endpoint:           "/games/1/teams",
+			requestBody:        `{"name":"Team 1"}`,
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Not supported in the game mode"}`,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup.sql")
+
+				if _, err := db.ExecContext(t.Context(), "UPDATE games SET game_mode = 'individual', team_size = 1 WHERE id = 1"); err != nil {
+					t.Fatalf("Failed to switch game mode: %v", err)
+				}
+			},
+		},
 		"Create team with players": {
 			method:             "POST",
 			endpoint:           "/games/1/teams",
@@ -42,6 +58,65 @@ func TestTeams(t *testing.T) {
 				executeSQLFile(t, db, "./test_data/games_setup.sql")
 			},
 		},
+		"Import teams from CSV": {
+			method:             "POST",
+			endpoint:           "/games/1/teams/import?delimiter=%3B&header=true",
+			requestBody:        "Team;Spieler\nTeam Müller;Jürgen Müller\nTeam Schön;Björn Schön\nTeam Müller;Käthe Müller\n",
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       `{"teams":[{"id":1,"name":"Team Müller","gameID":1,"players":[{"id":1,"name":"Jürgen Müller","teamID":1},{"id":2,"name":"Käthe Müller","teamID":1}]},{"id":2,"name":"Team Schön","gameID":1,"players":[{"id":3,"name":"Björn Schön","teamID":2}]}]}`,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup.sql")
+			},
+		},
+		"Import teams from CSV with invalid lines imports nothing": {
+			method:             "POST",
+			endpoint:           "/games/1/teams/import",
+			requestBody:        "Team 1,Player 1\nTeam 1,Player 2\nTeam 1,Player 3\nTeam 1,Player 4\nTeam 1,Player 5\nTeam 2,\nTeam 3,Player 6\n",
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusUnprocessableEntity,
+			expectedBody:       `{"error":"Invalid lines","lines":[{"line":5,"message":"team \"Team 1\" has more than 4 players"},{"line":6,"message":"player name is missing"}]}`,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup.sql")
+			},
+			assertions: func(t *testing.T, db *sql.DB) {
+				t.Helper()
+
+				var teams int
+
+				if err := db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM teams").Scan(&teams); err != nil {
+					t.Fatalf("Failed to query teams: %v", err)
+				}
+
+				assert.Equal(t, 0, teams)
+			},
+		},
+		"Import teams from CSV with an invalid delimiter": {
+			method:             "POST",
+			endpoint:           "/games/1/teams/import?delimiter=%3B%3B",
+			requestBody:        "Team 1;;Player 1\n",
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `{"error":"Invalid delimiter"}`,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup.sql")
+			},
+		},
+		"Import teams from CSV in an individual game": {
+			method:             "POST",
+			endpoint:           "/games/1/teams/import",
+			requestBody:        "Team 1,Player 1\n",
+			requestHeaders:     map[string]string{"Authorization": "Bearer sub-1"},
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"Not supported in the game mode"}`,
+			setup: func(db *sql.DB) {
+				executeSQLFile(t, db, "./test_data/games_setup.sql")
+
+				if _, err := db.ExecContext(t.Context(), "UPDATE games SET game_mode = 'individual', team_size = 1 WHERE id = 1"); err != nil {
+					t.Fatalf("Failed to switch game mode: %v", err)
+				}
+			},
+		},
 		"Create team not owner": {
 			method:             "POST",
 			endpoint:           "/games/1/teams",
@@ -0,0 +1,7 @@
+UPDATE players SET player_name = 'Jürgen Müller' WHERE id = 1;
+UPDATE players SET player_name = 'Erika Mustermann' WHERE id = 2;
+UPDATE players SET player_name = 'Max Mustermann' WHERE id = 3;
+UPDATE players SET player_name = 'Anna Schmidt' WHERE id = 4;
+
+INSERT INTO game_shares (game_id, token, abbreviate_names, created_at)
+VALUES (1, 'XK4H2MZQ7RT5WB3NJ6PLC2VDEY', TRUE, '2026-01-01 12:00:00+00');
@@ -54,15 +54,15 @@ VALUES (1, 'Player 1', 1),
 INSERT INTO rounds (id, round_number, game_id, status)
 VALUES (1, 1, 1, 'in_progress');
 
-INSERT INTO game_tables (id, table_number, round_id)
-VALUES (1, 1, 1),
-(2, 2, 1),
-(3, 3, 1),
-(4, 4, 1),
-(5, 5, 1),
-(6, 6, 1),
-(7, 7, 1),
-(8, 8, 1);
+INSERT INTO game_tables (id, table_number, round_id, capacity)
+VALUES (1, 1, 1, 4),
+(2, 2, 1, 4),
+(3, 3, 1, 4),
+(4, 4, 1, 4),
+(5, 5, 1, 4),
+(6, 6, 1, 4),
+(7, 7, 1, 4),
+(8, 8, 1, 4);
 
 INSERT INTO table_players (game_table_id, player_id)
 VALUES (1, 1),
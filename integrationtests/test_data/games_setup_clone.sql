@@ -0,0 +1,25 @@
+INSERT INTO games (
+    id, game_name, team_size, table_size, number_of_rounds, status
+)
+VALUES (1, 'Game 1', 2, 4, 1, 'in_progress');
+
+INSERT INTO game_owners (game_id, owner_sub)
+VALUES (1, 'sub-1'), (1, 'sub-2');
+
+INSERT INTO teams (id, team_name, game_id)
+VALUES (1, 'Team 1', 1),
+(2, 'Team 2', 1);
+
+INSERT INTO players (id, player_name, team_id, substitute)
+VALUES (1, 'Player 1', 1, FALSE),
+(2, 'Player 2', 1, FALSE),
+(3, 'Player 3', 2, FALSE),
+(4, 'Player 4', 2, TRUE);
+
+INSERT INTO rounds (id, round_number, game_id, status)
+VALUES (1, 1, 1, 'completed');
+
+SELECT setval('games_id_seq', 1);
+SELECT setval('teams_id_seq', 2);
+SELECT setval('players_id_seq', 4);
+SELECT setval('rounds_id_seq', 1);
@@ -0,0 +1,46 @@
+INSERT INTO games (
+    id, game_name, team_size, table_size, number_of_rounds, status
+)
+VALUES (1, 'Game 1', 2, 2, 2, 'in_progress');
+
+INSERT INTO game_owners (game_id, owner_sub)
+VALUES (1, 'sub-1');
+
+INSERT INTO teams (game_id, id, team_name)
+VALUES (1, 1, 'Team 1'),
+(1, 2, 'Team 2');
+
+INSERT INTO players (id, player_name, team_id)
+VALUES (1, 'Player 1', 1),
+(2, 'Player 2', 1),
+(3, 'Player 3', 2),
+(4, 'Player 4', 2);
+
+INSERT INTO rounds (id, round_number, game_id, status)
+VALUES (1, 1, 1, 'completed'),
+(2, 2, 1, 'in_progress');
+
+INSERT INTO game_tables (id, table_number, round_id, capacity)
+VALUES (1, 1, 1, 2),
+(2, 2, 1, 2),
+(3, 1, 2, 2),
+(4, 2, 2, 2);
+
+INSERT INTO table_players (game_table_id, player_id)
+VALUES (1, 1),
+(1, 3),
+(2, 2),
+(2, 4),
+(3, 1),
+(3, 4),
+(4, 2),
+(4, 3);
+
+INSERT INTO scores (id, player_id, table_id, score)
+VALUES (1, 1, 1, 3),
+(2, 3, 1, 5),
+(3, 2, 2, 2),
+(4, 4, 2, 2),
+(5, 1, 3, 4),
+(6, 4, 3, 1),
+(7, 2, 4, 6);
@@ -52,26 +52,26 @@ VALUES (1, 'Player 1', 1),
 (32, 'Player 32', 8);
 
 INSERT INTO rounds (id, round_number, game_id, status)
-VALUES (1, 1, 1, 'ready'),
-(2, 2, 1, 'ready');
+VALUES (1, 1, 1, 'setup'),
+(2, 2, 1, 'setup');
 
-INSERT INTO game_tables (id, table_number, round_id)
-VALUES (1, 1, 1),
-(2, 2, 1),
-(3, 3, 1),
-(4, 4, 1),
-(5, 5, 1),
-(6, 6, 1),
-(7, 7, 1),
-(8, 8, 1),
-(9, 1, 2),
-(10, 2, 2),
-(11, 3, 2),
-(12, 4, 2),
-(13, 5, 2),
-(14, 6, 2),
-(15, 7, 2),
-(16, 8, 2);
+INSERT INTO game_tables (id, table_number, round_id, capacity)
+VALUES (1, 1, 1, 4),
+(2, 2, 1, 4),
+(3, 3, 1, 4),
+(4, 4, 1, 4),
+(5, 5, 1, 4),
+(6, 6, 1, 4),
+(7, 7, 1, 4),
+(8, 8, 1, 4),
+(9, 1, 2, 4),
+(10, 2, 2, 4),
+(11, 3, 2, 4),
+(12, 4, 2, 4),
+(13, 5, 2, 4),
+(14, 6, 2, 4),
+(15, 7, 2, 4),
+(16, 8, 2, 4);
 
 
 INSERT INTO table_players (game_table_id, player_id)
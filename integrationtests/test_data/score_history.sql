@@ -0,0 +1,6 @@
+INSERT INTO score_history (
+    id, table_id, game_id, round_number, table_number, player_id, player_name, old_score, new_score, owner_sub, reason, created_at
+)
+VALUES (1, 1, 1, 1, 1, 1, 'Player 1', NULL, 2, 'sub-1', NULL, '2025-01-01T19:00:00Z'),
+(2, 1, 1, 1, 1, 3, 'Player 3', NULL, 5, 'sub-1', NULL, '2025-01-01T19:00:00Z'),
+(3, 1, 1, 1, 1, 1, 'Player 1', 2, 3, 'sub-1', 'Typo', '2025-01-01T20:00:00Z');
@@ -10,10 +10,53 @@ var (
 	ErrTableAssignment      = errors.New("cannot assign players to tables")
 	ErrInvalidScore         = errors.New("invalid score")
 	ErrRoundOrTableNotFound = errors.New("round or table not found")
+	ErrRoundNotFound        = errors.New("round not found")
+	ErrRoundNotInProgress   = errors.New("round is not in progress")
+	ErrRoundInProgress      = errors.New("another round is already in progress")
+	ErrGameNotInProgress    = errors.New("game is not in progress")
+	ErrRoundNotInSetup      = errors.New("round has already been started")
+	ErrRoundIncomplete      = errors.New("round has missing scores")
+	ErrReasonRequired       = errors.New("a reason is required to correct a completed round")
+	ErrNotSwissPairing      = errors.New("game does not use swiss pairing")
+	ErrRoundNotCompleted    = errors.New("previous round is not completed")
+	ErrRoundCompleted       = errors.New("round is already completed")
+	ErrRoundScored          = errors.New("round already has scores")
+	ErrPlayerNotSeated      = errors.New("player is not seated in the round")
+	ErrPlayerAlreadyScored  = errors.New("player already has a score in the round")
+	ErrInvalidSubstitute    = errors.New("substitute must be a teammate not playing in the round")
+	ErrSameTable            = errors.New("players already sit at the same table")
+	ErrTableFull            = errors.New("table has no free seat")
+	ErrTableTooSmall        = errors.New("seat change would leave a table with fewer than two players")
+	ErrTableScored          = errors.New("table already has scores")
+	ErrTeammatesSeated      = errors.New("seat change would seat teammates together")
+	ErrInvalidSeatOrder     = errors.New("seat order must list every player of the table once")
+	ErrAllRoundsDrawn       = errors.New("all rounds have been drawn")
+	ErrDrawNotReproducible  = errors.New("draw cannot be reproduced from the recorded seed")
+	ErrPreviewOutdated      = errors.New("draw preview no longer matches the game")
+	ErrFinalOrTableNotFound = errors.New("final or table not found")
+	ErrFinalExists          = errors.New("game already has a final")
+	ErrFinalCompleted       = errors.New("final is already completed")
+	ErrFinalIncomplete      = errors.New("final has missing scores")
+	ErrPreliminariesOpen    = errors.New("preliminary rounds are not completed")
+	ErrInvalidFinalists     = errors.New("invalid number of finalists")
+	ErrStageNotFound        = errors.New("stage not found")
+	ErrInvalidStages        = errors.New("invalid stage configuration")
+	ErrStagesLocked         = errors.New("stages cannot be changed once rounds have been drawn")
+	ErrSeatingLocked        = errors.New("seating cannot be changed once rounds have been drawn")
+	ErrStagedGame           = errors.New("game is played in stages")
+	ErrStageIncomplete      = errors.New("current stage is not completed")
+	ErrNoNextStage          = errors.New("game has no further stage")
+	ErrInvalidArchive       = errors.New("invalid game archive")
+	ErrArchiveVersion       = errors.New("unsupported game archive version")
+	ErrInvalidImport        = errors.New("import has invalid lines")
+	ErrEmptyImport          = errors.New("import has no team")
 	ErrTeamSizeNotAllowed   = errors.New("team size not allowed")
+	ErrGameModeMismatch     = errors.New("not supported by the mode of the game")
+	ErrSwissSubstitution    = errors.New("swiss rounds are drawn from the team rosters")
 	ErrInvalidGameSetup     = errors.New("invalid game setup")
 	ErrGameIncomplete       = errors.New("game is complete")
 	ErrUserNotFound         = errors.New("no user found for the given email")
 	ErrAlreadyOwner         = errors.New("user is already an owner")
 	ErrLastOwner            = errors.New("cannot remove the last owner")
+	ErrShareNotFound        = errors.New("game is not shared")
 )
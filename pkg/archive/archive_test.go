@@ -0,0 +1,108 @@
+package archive
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/henok321/knobel-manager-service/gen/api"
+	"github.com/henok321/knobel-manager-service/pkg/apperror"
+)
+
+const gameArchive = `{
+  "version": 1,
+  "game": {
+    "name": "Spring Cup",
+    "teamSize": 2,
+    "tableSize": 2,
+    "numberOfRounds": 1,
+    "status": "completed",
+    "mode": "team",
+    "pairingMode": "random",
+    "ranking": {"scoreDirection": "higher_wins", "tieBreakers": ["best_round", "coin_flip"], "coinFlipSeed": 42},
+    "seating": {"shortTables": false, "byes": true, "byeScore": 3},
+    "tableLayout": [2, 2],
+    "draw": {"seed": 7, "algorithm": "assign-rounds-v1"},
+    "teams": [
+      {"id": 1, "name": "Team 1", "players": [{"id": 1, "name": "Player 1"}, {"id": 2, "name": "Player 2"}]},
+      {"id": 2, "name": "Team 2", "players": [{"id": 3, "name": "Player 3"}, {"id": 4, "name": "Player 4"}, {"id": 5, "name": "Player 5", "substitute": true}]}
+    ],
+    "stages": [
+      {"id": 9, "position": 1, "name": "Groups", "pairingMode": "random", "numberOfRounds": 1, "groups": 1,
+       "qualification": "all", "qualifiers": 0, "carryOver": "none", "status": "completed",
+       "players": [{"playerID": 1, "group": 1, "carriedScore": 0}, {"playerID": 3, "group": 1, "carriedScore": 0}]}
+    ],
+    "rounds": [
+      {"roundNumber": 1, "status": "completed", "stageID": 9, "byes": [2],
+       "tables": [
+         {"tableNumber": 1, "capacity": 2, "stageID": 9,
+          "seats": [{"playerID": 3, "seat": 1}, {"playerID": 1, "seat": 2}],
+          "scores": [{"playerID": 1, "score": 4}, {"playerID": 5, "score": 6}]}
+       ],
+       "substitutions": [{"tableNumber": 1, "playerID": 3, "substituteID": 5}]}
+    ],
+    "final": {"finalists": 2, "status": "completed",
+              "tables": [{"tableNumber": 1, "players": [{"playerID": 5, "seed": 1, "score": 8}, {"playerID": 1, "seed": 2, "score": 2}]}]}
+  }
+}`
+
+func readArchive(t *testing.T, raw string) api.GameArchive {
+	t.Helper()
+
+	var archived api.GameArchive
+	require.NoError(t, json.Unmarshal([]byte(raw), &archived))
+
+	return archived
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	archived := readArchive(t, gameArchive)
+
+	game, err := ToGame(archived)
+	require.NoError(t, err)
+
+	assert.Equal(t, archived, FromGame(game), "the archive is written back unchanged")
+
+	require.Len(t, game.Rounds, 1)
+	round := game.Rounds[0]
+	require.Len(t, round.Substitutions, 1)
+	assert.Same(t, round.Tables[0], round.Substitutions[0].Table, "substitutions point to their table")
+	assert.Equal(t, 2, *game.Teams[1].Players[0].TeamID)
+}
+
+func TestToGameRejectsInvalidArchives(t *testing.T) {
+	tests := map[string]struct {
+		archive  string
+		expected error
+	}{
+		"unsupported version": {
+			archive:  `{"version": 2, "game": {"name": "Game", "teamSize": 1, "tableSize": 2, "numberOfRounds": 1, "status": "setup", "mode": "individual", "pairingMode": "random", "ranking": {"scoreDirection": "higher_wins", "tieBreakers": []}, "seating": {"shortTables": false, "byes": false, "byeScore": 0}}}`,
+			expected: apperror.ErrArchiveVersion,
+		},
+		"unknown status": {
+			archive:  `{"version": 1, "game": {"name": "Game", "teamSize": 1, "tableSize": 2, "numberOfRounds": 1, "status": "paused", "mode": "individual", "pairingMode": "random", "ranking": {"scoreDirection": "higher_wins", "tieBreakers": []}, "seating": {"shortTables": false, "byes": false, "byeScore": 0}}}`,
+			expected: apperror.ErrInvalidArchive,
+		},
+		"duplicate player": {
+			archive:  `{"version": 1, "game": {"name": "Game", "teamSize": 1, "tableSize": 2, "numberOfRounds": 1, "status": "setup", "mode": "individual", "pairingMode": "random", "ranking": {"scoreDirection": "higher_wins", "tieBreakers": []}, "seating": {"shortTables": false, "byes": false, "byeScore": 0}, "players": [{"id": 1, "name": "Player 1"}, {"id": 1, "name": "Player 2"}]}}`,
+			expected: apperror.ErrInvalidArchive,
+		},
+		"score of an unknown player": {
+			archive:  `{"version": 1, "game": {"name": "Game", "teamSize": 1, "tableSize": 2, "numberOfRounds": 1, "status": "setup", "mode": "individual", "pairingMode": "random", "ranking": {"scoreDirection": "higher_wins", "tieBreakers": []}, "seating": {"shortTables": false, "byes": false, "byeScore": 0}, "players": [{"id": 1, "name": "Player 1"}], "rounds": [{"roundNumber": 1, "status": "setup", "tables": [{"tableNumber": 1, "capacity": 2, "scores": [{"playerID": 2, "score": 1}]}]}]}}`,
+			expected: apperror.ErrInvalidArchive,
+		},
+		"round of an unknown stage": {
+			archive:  `{"version": 1, "game": {"name": "Game", "teamSize": 1, "tableSize": 2, "numberOfRounds": 1, "status": "setup", "mode": "individual", "pairingMode": "random", "ranking": {"scoreDirection": "higher_wins", "tieBreakers": []}, "seating": {"shortTables": false, "byes": false, "byeScore": 0}, "rounds": [{"roundNumber": 1, "status": "setup", "stageID": 3}]}}`,
+			expected: apperror.ErrInvalidArchive,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ToGame(readArchive(t, tc.archive))
+			assert.ErrorIs(t, err, tc.expected)
+		})
+	}
+}
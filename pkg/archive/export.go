@@ -0,0 +1,256 @@
+package archive
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/henok321/knobel-manager-service/gen/api"
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+// Version is the version of the archive format written by FromGame and read by ToGame.
+const Version = 1
+
+// FromGame writes a game with its teams, players, stages, rounds, tables, seats, scores, byes,
+// substitutions and final to an archive. The owners are left out, as they belong to the instance the
+// game is exported from. Rounds, tables, seats and scores are ordered by number, seat and player.
+func FromGame(game entity.Game) api.GameArchive {
+	archived := api.ArchiveGame{
+		Name:           game.Name,
+		TeamSize:       game.TeamSize,
+		TableSize:      game.TableSize,
+		NumberOfRounds: game.NumberOfRounds,
+		Status:         api.GameStatus(game.Status),
+		Mode:           api.GameMode(game.Mode),
+		PairingMode:    api.PairingMode(game.PairingMode),
+		Ranking: api.Ranking{
+			ScoreDirection: api.ScoreDirection(game.ScoreDirection),
+			TieBreakers:    make([]api.TieBreaker, len(game.TieBreakers)),
+		},
+		Seating: api.Seating{
+			ShortTables: game.ShortTables,
+			Byes:        game.Byes,
+			ByeScore:    game.ByeScore,
+		},
+	}
+
+	for i, tieBreaker := range game.TieBreakers {
+		archived.Ranking.TieBreakers[i] = api.TieBreaker(tieBreaker)
+	}
+
+	if game.CoinFlipSeed != 0 {
+		seed := game.CoinFlipSeed
+		archived.Ranking.CoinFlipSeed = &seed
+	}
+
+	if len(game.TableLayout) > 0 {
+		tableLayout := api.TableLayout(slices.Clone(game.TableLayout))
+		archived.TableLayout = &tableLayout
+	}
+
+	if game.DrawSeed != nil && game.DrawAlgorithm != nil {
+		archived.Draw = &api.Draw{Seed: *game.DrawSeed, Algorithm: *game.DrawAlgorithm}
+	}
+
+	if len(game.Teams) > 0 {
+		teams := make([]api.ArchiveTeam, len(game.Teams))
+		for i, team := range game.Teams {
+			teams[i] = api.ArchiveTeam{Id: team.ID, Name: team.Name, Players: archivePlayers(team.Players)}
+		}
+
+		archived.Teams = &teams
+	}
+
+	archived.Players = archivePlayers(game.Players)
+
+	if len(game.Stages) > 0 {
+		stages := make([]api.Stage, len(game.Stages))
+		for i, stage := range game.Stages {
+			stages[i] = archiveStage(*stage)
+		}
+
+		archived.Stages = &stages
+	}
+
+	if len(game.Rounds) > 0 {
+		rounds := slices.SortedFunc(slices.Values(game.Rounds), func(a, b *entity.Round) int {
+			return cmp.Compare(a.RoundNumber, b.RoundNumber)
+		})
+
+		archivedRounds := make([]api.ArchiveRound, len(rounds))
+		for i, round := range rounds {
+			archivedRounds[i] = archiveRound(*round)
+		}
+
+		archived.Rounds = &archivedRounds
+	}
+
+	if game.Final != nil {
+		final := archiveFinal(*game.Final)
+		archived.Final = &final
+	}
+
+	return api.GameArchive{Version: Version, Game: archived}
+}
+
+func archivePlayers(players []*entity.Player) *[]api.ArchivePlayer {
+	if len(players) == 0 {
+		return nil
+	}
+
+	archived := make([]api.ArchivePlayer, len(players))
+	for i, player := range players {
+		archived[i] = api.ArchivePlayer{Id: player.ID, Name: player.Name}
+
+		if player.Substitute {
+			substitute := true
+			archived[i].Substitute = &substitute
+		}
+	}
+
+	return &archived
+}
+
+func archiveStage(stage entity.Stage) api.Stage {
+	archived := api.Stage{
+		Id:             stage.ID,
+		Position:       stage.Position,
+		Name:           stage.Name,
+		PairingMode:    api.PairingMode(stage.PairingMode),
+		NumberOfRounds: stage.NumberOfRounds,
+		Groups:         stage.Groups,
+		Qualification:  api.QualificationRule(stage.Qualification),
+		Qualifiers:     stage.Qualifiers,
+		CarryOver:      api.CarryOver(stage.CarryOver),
+		Status:         api.StageStatus(stage.Status),
+	}
+
+	if len(stage.Players) > 0 {
+		players := make([]api.StagePlayer, len(stage.Players))
+		for i, player := range stage.Players {
+			players[i] = api.StagePlayer{PlayerID: player.PlayerID, Group: player.GroupNumber, CarriedScore: player.CarriedScore}
+		}
+
+		slices.SortFunc(players, func(a, b api.StagePlayer) int {
+			return cmp.Compare(a.PlayerID, b.PlayerID)
+		})
+
+		archived.Players = &players
+	}
+
+	return archived
+}
+
+func archiveRound(round entity.Round) api.ArchiveRound {
+	archived := api.ArchiveRound{RoundNumber: round.RoundNumber, Status: api.RoundStatus(round.Status), StageID: round.StageID}
+
+	tableNumbers := make(map[int]int, len(round.Tables))
+
+	if len(round.Tables) > 0 {
+		tables := make([]api.ArchiveTable, len(round.Tables))
+		for i, table := range round.Tables {
+			tableNumbers[table.ID] = table.TableNumber
+			tables[i] = archiveTable(*table)
+		}
+
+		slices.SortFunc(tables, func(a, b api.ArchiveTable) int {
+			return cmp.Compare(a.TableNumber, b.TableNumber)
+		})
+
+		archived.Tables = &tables
+	}
+
+	if len(round.Byes) > 0 {
+		byes := make([]int, len(round.Byes))
+		for i, bye := range round.Byes {
+			byes[i] = bye.PlayerID
+		}
+
+		slices.Sort(byes)
+		archived.Byes = &byes
+	}
+
+	if len(round.Substitutions) > 0 {
+		substitutions := make([]api.ArchiveSubstitution, len(round.Substitutions))
+		for i, substitution := range round.Substitutions {
+			tableNumber := tableNumbers[substitution.TableID]
+			if substitution.Table != nil {
+				tableNumber = substitution.Table.TableNumber
+			}
+
+			substitutions[i] = api.ArchiveSubstitution{TableNumber: tableNumber, PlayerID: substitution.PlayerID, SubstituteID: substitution.SubstituteID}
+		}
+
+		slices.SortFunc(substitutions, func(a, b api.ArchiveSubstitution) int {
+			return cmp.Or(cmp.Compare(a.TableNumber, b.TableNumber), cmp.Compare(a.PlayerID, b.PlayerID))
+		})
+
+		archived.Substitutions = &substitutions
+	}
+
+	return archived
+}
+
+func archiveTable(table entity.GameTable) api.ArchiveTable {
+	archived := api.ArchiveTable{TableNumber: table.TableNumber, Capacity: table.Capacity, StageID: table.StageID}
+
+	if len(table.Seats) > 0 {
+		seats := make([]api.ArchiveSeat, len(table.Seats))
+		for i, seat := range table.Seats {
+			seats[i] = api.ArchiveSeat{PlayerID: seat.PlayerID, Seat: seat.Seat}
+		}
+
+		slices.SortFunc(seats, func(a, b api.ArchiveSeat) int {
+			return cmp.Or(cmp.Compare(a.Seat, b.Seat), cmp.Compare(a.PlayerID, b.PlayerID))
+		})
+
+		archived.Seats = &seats
+	}
+
+	if len(table.Scores) > 0 {
+		scores := make([]api.ArchiveScore, len(table.Scores))
+		for i, score := range table.Scores {
+			scores[i] = api.ArchiveScore{PlayerID: score.PlayerID, Score: score.Score}
+		}
+
+		slices.SortFunc(scores, func(a, b api.ArchiveScore) int {
+			return cmp.Compare(a.PlayerID, b.PlayerID)
+		})
+
+		archived.Scores = &scores
+	}
+
+	return archived
+}
+
+func archiveFinal(final entity.Final) api.ArchiveFinal {
+	archived := api.ArchiveFinal{Finalists: final.Finalists, Status: api.FinalStatus(final.Status)}
+
+	if len(final.Tables) > 0 {
+		tables := make([]api.ArchiveFinalTable, len(final.Tables))
+		for i, table := range final.Tables {
+			tables[i] = api.ArchiveFinalTable{TableNumber: table.TableNumber}
+
+			if len(table.Players) > 0 {
+				players := make([]api.FinalPlayer, len(table.Players))
+				for j, player := range table.Players {
+					players[j] = api.FinalPlayer{PlayerID: player.PlayerID, Seed: player.Seed, Score: player.Score}
+				}
+
+				slices.SortFunc(players, func(a, b api.FinalPlayer) int {
+					return cmp.Compare(a.Seed, b.Seed)
+				})
+
+				tables[i].Players = &players
+			}
+		}
+
+		slices.SortFunc(tables, func(a, b api.ArchiveFinalTable) int {
+			return cmp.Compare(a.TableNumber, b.TableNumber)
+		})
+
+		archived.Tables = &tables
+	}
+
+	return archived
+}
@@ -0,0 +1,310 @@
+package archive
+
+import (
+	"slices"
+
+	"github.com/henok321/knobel-manager-service/gen/api"
+	"github.com/henok321/knobel-manager-service/pkg/apperror"
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+// ToGame reads a game from an archive. The game keeps the IDs of the archive, which link its parts
+// the way they were linked in the exported game; substitutions point to their table, as the archive
+// holds no table IDs. Archives of another version are rejected, as are archives with duplicate IDs or
+// numbers, unknown values or parts referring to players, stages or tables missing from the archive.
+func ToGame(archive api.GameArchive) (entity.Game, error) {
+	if archive.Version != Version {
+		return entity.Game{}, apperror.ErrArchiveVersion
+	}
+
+	archived := archive.Game
+
+	if !validGame(archived) {
+		return entity.Game{}, apperror.ErrInvalidArchive
+	}
+
+	game := entity.Game{
+		Name:           archived.Name,
+		TeamSize:       archived.TeamSize,
+		TableSize:      archived.TableSize,
+		NumberOfRounds: archived.NumberOfRounds,
+		Status:         entity.GameStatus(archived.Status),
+		Mode:           entity.GameMode(archived.Mode),
+		PairingMode:    entity.PairingMode(archived.PairingMode),
+		ScoreDirection: entity.ScoreDirection(archived.Ranking.ScoreDirection),
+		TieBreakers:    make(entity.TieBreakers, len(archived.Ranking.TieBreakers)),
+		ShortTables:    archived.Seating.ShortTables,
+		Byes:           archived.Seating.Byes,
+		ByeScore:       archived.Seating.ByeScore,
+	}
+
+	for i, tieBreaker := range archived.Ranking.TieBreakers {
+		game.TieBreakers[i] = entity.TieBreaker(tieBreaker)
+	}
+
+	if archived.Ranking.CoinFlipSeed != nil {
+		game.CoinFlipSeed = *archived.Ranking.CoinFlipSeed
+	}
+
+	if archived.TableLayout != nil {
+		game.TableLayout = entity.TableLayout(slices.Clone(*archived.TableLayout))
+	}
+
+	if archived.Draw != nil {
+		seed, algorithm := archived.Draw.Seed, archived.Draw.Algorithm
+		game.DrawSeed = &seed
+		game.DrawAlgorithm = &algorithm
+	}
+
+	r := reader{players: map[int]bool{}, stages: map[int]bool{}}
+
+	teamIDs := map[int]bool{}
+	for _, team := range values(archived.Teams) {
+		if teamIDs[team.Id] || team.Name == "" {
+			return entity.Game{}, apperror.ErrInvalidArchive
+		}
+
+		teamIDs[team.Id] = true
+
+		players, err := r.readPlayers(values(team.Players), &team.Id)
+		if err != nil {
+			return entity.Game{}, err
+		}
+
+		game.Teams = append(game.Teams, &entity.Team{ID: team.Id, Name: team.Name, Players: players})
+	}
+
+	players, err := r.readPlayers(values(archived.Players), nil)
+	if err != nil {
+		return entity.Game{}, err
+	}
+
+	game.Players = players
+
+	for _, stage := range values(archived.Stages) {
+		readStage, err := r.readStage(stage)
+		if err != nil {
+			return entity.Game{}, err
+		}
+
+		game.Stages = append(game.Stages, readStage)
+	}
+
+	roundNumbers := map[int]bool{}
+	for _, round := range values(archived.Rounds) {
+		if roundNumbers[round.RoundNumber] {
+			return entity.Game{}, apperror.ErrInvalidArchive
+		}
+
+		roundNumbers[round.RoundNumber] = true
+
+		readRound, err := r.readRound(round)
+		if err != nil {
+			return entity.Game{}, err
+		}
+
+		game.Rounds = append(game.Rounds, readRound)
+	}
+
+	if archived.Final != nil {
+		final, err := r.readFinal(*archived.Final)
+		if err != nil {
+			return entity.Game{}, err
+		}
+
+		game.Final = final
+	}
+
+	return game, nil
+}
+
+func validGame(archived api.ArchiveGame) bool {
+	if archived.Name == "" || archived.TeamSize < 1 || archived.TableSize < 1 || archived.NumberOfRounds < 1 {
+		return false
+	}
+
+	if !archived.Status.Valid() || !archived.Mode.Valid() || !archived.PairingMode.Valid() || !archived.Ranking.ScoreDirection.Valid() {
+		return false
+	}
+
+	for _, tieBreaker := range archived.Ranking.TieBreakers {
+		if !tieBreaker.Valid() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// reader keeps the IDs of the players and stages read so far, which the later parts of an archive
+// may refer to.
+type reader struct {
+	players map[int]bool
+	stages  map[int]bool
+}
+
+func (r *reader) readPlayers(archived []api.ArchivePlayer, teamID *int) ([]*entity.Player, error) {
+	var players []*entity.Player
+
+	for _, player := range archived {
+		if r.players[player.Id] || player.Name == "" {
+			return nil, apperror.ErrInvalidArchive
+		}
+
+		r.players[player.Id] = true
+
+		players = append(players, &entity.Player{
+			ID:         player.Id,
+			Name:       player.Name,
+			TeamID:     teamID,
+			Substitute: player.Substitute != nil && *player.Substitute,
+		})
+	}
+
+	return players, nil
+}
+
+func (r *reader) readStage(archived api.Stage) (*entity.Stage, error) {
+	if r.stages[archived.Id] || !archived.PairingMode.Valid() || !archived.Qualification.Valid() ||
+		!archived.CarryOver.Valid() || !archived.Status.Valid() {
+		return nil, apperror.ErrInvalidArchive
+	}
+
+	r.stages[archived.Id] = true
+
+	stage := &entity.Stage{
+		ID:             archived.Id,
+		Position:       archived.Position,
+		Name:           archived.Name,
+		PairingMode:    entity.PairingMode(archived.PairingMode),
+		NumberOfRounds: archived.NumberOfRounds,
+		Groups:         archived.Groups,
+		Qualification:  entity.QualificationRule(archived.Qualification),
+		Qualifiers:     archived.Qualifiers,
+		CarryOver:      entity.CarryOver(archived.CarryOver),
+		Status:         entity.StageStatus(archived.Status),
+	}
+
+	for _, player := range values(archived.Players) {
+		if !r.players[player.PlayerID] {
+			return nil, apperror.ErrInvalidArchive
+		}
+
+		stage.Players = append(stage.Players, &entity.StagePlayer{
+			StageID:      archived.Id,
+			PlayerID:     player.PlayerID,
+			GroupNumber:  player.Group,
+			CarriedScore: player.CarriedScore,
+		})
+	}
+
+	return stage, nil
+}
+
+func (r *reader) readRound(archived api.ArchiveRound) (*entity.Round, error) {
+	if !archived.Status.Valid() || !r.knownStage(archived.StageID) {
+		return nil, apperror.ErrInvalidArchive
+	}
+
+	round := &entity.Round{RoundNumber: archived.RoundNumber, StageID: archived.StageID, Status: entity.RoundStatus(archived.Status)}
+
+	tables := map[int]*entity.GameTable{}
+	for _, archivedTable := range values(archived.Tables) {
+		if tables[archivedTable.TableNumber] != nil || !r.knownStage(archivedTable.StageID) {
+			return nil, apperror.ErrInvalidArchive
+		}
+
+		table := &entity.GameTable{TableNumber: archivedTable.TableNumber, StageID: archivedTable.StageID, Capacity: archivedTable.Capacity}
+
+		for _, seat := range values(archivedTable.Seats) {
+			if !r.players[seat.PlayerID] {
+				return nil, apperror.ErrInvalidArchive
+			}
+
+			table.Seats = append(table.Seats, &entity.TablePlayer{PlayerID: seat.PlayerID, Seat: seat.Seat})
+		}
+
+		for _, score := range values(archivedTable.Scores) {
+			if !r.players[score.PlayerID] {
+				return nil, apperror.ErrInvalidArchive
+			}
+
+			table.Scores = append(table.Scores, &entity.Score{PlayerID: score.PlayerID, Score: score.Score})
+		}
+
+		tables[table.TableNumber] = table
+		round.Tables = append(round.Tables, table)
+	}
+
+	for _, playerID := range values(archived.Byes) {
+		if !r.players[playerID] {
+			return nil, apperror.ErrInvalidArchive
+		}
+
+		round.Byes = append(round.Byes, &entity.RoundBye{PlayerID: playerID})
+	}
+
+	for _, substitution := range values(archived.Substitutions) {
+		table := tables[substitution.TableNumber]
+		if table == nil || !r.players[substitution.PlayerID] || !r.players[substitution.SubstituteID] {
+			return nil, apperror.ErrInvalidArchive
+		}
+
+		round.Substitutions = append(round.Substitutions, &entity.Substitution{
+			Table:        table,
+			PlayerID:     substitution.PlayerID,
+			SubstituteID: substitution.SubstituteID,
+		})
+	}
+
+	return round, nil
+}
+
+func (r *reader) readFinal(archived api.ArchiveFinal) (*entity.Final, error) {
+	if !archived.Status.Valid() {
+		return nil, apperror.ErrInvalidArchive
+	}
+
+	final := &entity.Final{Finalists: archived.Finalists, Status: entity.FinalStatus(archived.Status)}
+
+	tableNumbers := map[int]bool{}
+	for _, archivedTable := range values(archived.Tables) {
+		if tableNumbers[archivedTable.TableNumber] {
+			return nil, apperror.ErrInvalidArchive
+		}
+
+		tableNumbers[archivedTable.TableNumber] = true
+
+		table := &entity.FinalTable{TableNumber: archivedTable.TableNumber}
+
+		for _, player := range values(archivedTable.Players) {
+			if !r.players[player.PlayerID] {
+				return nil, apperror.ErrInvalidArchive
+			}
+
+			finalPlayer := &entity.FinalPlayer{PlayerID: player.PlayerID, Seed: player.Seed}
+			if player.Score != nil {
+				score := *player.Score
+				finalPlayer.Score = &score
+			}
+
+			table.Players = append(table.Players, finalPlayer)
+		}
+
+		final.Tables = append(final.Tables, table)
+	}
+
+	return final, nil
+}
+
+func (r *reader) knownStage(stageID *int) bool {
+	return stageID == nil || r.stages[*stageID]
+}
+
+func values[T any](list *[]T) []T {
+	if list == nil {
+		return nil
+	}
+
+	return *list
+}
@@ -1,6 +1,12 @@
 package entity
 
 import (
+	"cmp"
+	"database/sql/driver"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -12,6 +18,165 @@ const (
 	StatusCompleted  GameStatus = "completed"
 )
 
+type RoundStatus string
+
+const (
+	RoundStatusSetup      RoundStatus = "setup"
+	RoundStatusInProgress RoundStatus = "in_progress"
+	RoundStatusCompleted  RoundStatus = "completed"
+)
+
+type FinalStatus string
+
+const (
+	FinalStatusInProgress FinalStatus = "in_progress"
+	FinalStatusCompleted  FinalStatus = "completed"
+)
+
+type StageStatus string
+
+const (
+	StageStatusPending    StageStatus = "pending"
+	StageStatusInProgress StageStatus = "in_progress"
+	StageStatusCompleted  StageStatus = "completed"
+)
+
+type QualificationRule string
+
+const (
+	// QualifyAll lets every player of the game take part, used by the first stage.
+	QualifyAll QualificationRule = "all"
+	// QualifyTopOverall takes the best players of the previous stage.
+	QualifyTopOverall QualificationRule = "top_overall"
+	// QualifyTopPerGroup takes the best players of every group of the previous stage.
+	QualifyTopPerGroup QualificationRule = "top_per_group"
+)
+
+type CarryOver string
+
+const (
+	// CarryOverNone starts every qualifier of a stage at zero.
+	CarryOverNone CarryOver = "none"
+	// CarryOverFull starts every qualifier of a stage with the total score of the previous stage.
+	CarryOverFull CarryOver = "full"
+)
+
+type PairingMode string
+
+const (
+	// PairingRandom draws every round at setup time.
+	PairingRandom PairingMode = "random"
+	// PairingSwiss draws only the first round at setup time and every further round from the current standings.
+	PairingSwiss PairingMode = "swiss"
+)
+
+type GameMode string
+
+const (
+	// GameModeTeam seats teams of players and ranks teams and players.
+	GameModeTeam GameMode = "team"
+	// GameModeIndividual registers players directly against the game and ranks players only.
+	GameModeIndividual GameMode = "individual"
+)
+
+type ScoreDirection string
+
+const (
+	HigherWins ScoreDirection = "higher_wins"
+	LowerWins  ScoreDirection = "lower_wins"
+)
+
+type TieBreaker string
+
+const (
+	TieBreakerBestRound        TieBreaker = "best_round"
+	TieBreakerTableWins        TieBreaker = "table_wins"
+	TieBreakerHeadToHead       TieBreaker = "head_to_head"
+	TieBreakerFewestZeroRounds TieBreaker = "fewest_zero_rounds"
+	TieBreakerCoinFlip         TieBreaker = "coin_flip"
+)
+
+// TieBreakers is the ordered tie-breaker chain of a game, stored as a comma separated list.
+type TieBreakers []TieBreaker
+
+func (t *TieBreakers) Scan(value any) error {
+	var raw string
+
+	switch v := value.(type) {
+	case nil:
+	case string:
+		raw = v
+	case []byte:
+		raw = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into TieBreakers", value)
+	}
+
+	*t = TieBreakers{}
+
+	if raw == "" {
+		return nil
+	}
+
+	for _, tieBreaker := range strings.Split(raw, ",") {
+		*t = append(*t, TieBreaker(tieBreaker))
+	}
+
+	return nil
+}
+
+func (t TieBreakers) Value() (driver.Value, error) {
+	parts := make([]string, len(t))
+	for i, tieBreaker := range t {
+		parts[i] = string(tieBreaker)
+	}
+
+	return strings.Join(parts, ","), nil
+}
+
+// TableLayout lists the seats of every table of a round, stored as a comma separated list.
+type TableLayout []int
+
+func (t *TableLayout) Scan(value any) error {
+	var raw string
+
+	switch v := value.(type) {
+	case nil:
+	case string:
+		raw = v
+	case []byte:
+		raw = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into TableLayout", value)
+	}
+
+	*t = TableLayout{}
+
+	if raw == "" {
+		return nil
+	}
+
+	for _, part := range strings.Split(raw, ",") {
+		seats, err := strconv.Atoi(part)
+		if err != nil {
+			return fmt.Errorf("cannot scan %q into TableLayout: %w", raw, err)
+		}
+
+		*t = append(*t, seats)
+	}
+
+	return nil
+}
+
+func (t TableLayout) Value() (driver.Value, error) {
+	parts := make([]string, len(t))
+	for i, seats := range t {
+		parts[i] = strconv.Itoa(seats)
+	}
+
+	return strings.Join(parts, ","), nil
+}
+
 func IsOwner(game Game, sub string) bool {
 	for _, owner := range game.Owners {
 		if owner.OwnerSub == sub {
@@ -23,19 +188,44 @@ func IsOwner(game Game, sub string) bool {
 }
 
 type Game struct {
-	ID             int          `gorm:"primaryKey"`
-	Name           string       `gorm:"column:game_name;size:255;not null"`
-	TeamSize       int          `gorm:"not null"`
-	TableSize      int          `gorm:"not null"`
-	NumberOfRounds int          `gorm:"not null"`
-	Status         GameStatus   `gorm:"size:50;not null"`
-	Owners         []*GameOwner `gorm:"foreignKey:GameID;constraint:OnDelete:CASCADE"`
-	Teams          []*Team      `gorm:"foreignKey:GameID"`
-	Rounds         []*Round     `gorm:"foreignKey:GameID"`
+	ID             int            `gorm:"primaryKey"`
+	Name           string         `gorm:"column:game_name;size:255;not null"`
+	TeamSize       int            `gorm:"not null"`
+	TableSize      int            `gorm:"not null"`
+	NumberOfRounds int            `gorm:"not null"`
+	Status         GameStatus     `gorm:"size:50;not null"`
+	Mode           GameMode       `gorm:"column:game_mode;size:50;not null;default:team"`
+	PairingMode    PairingMode    `gorm:"size:50;not null;default:random"`
+	ScoreDirection ScoreDirection `gorm:"size:50;not null;default:higher_wins"`
+	TieBreakers    TieBreakers    `gorm:"type:varchar(255);not null;default:''"`
+	CoinFlipSeed   int64          `gorm:"not null;default:0"`
+	DrawSeed       *int64         `gorm:"default:null"`
+	DrawAlgorithm  *string        `gorm:"size:50"`
+	ShortTables    bool           `gorm:"not null;default:false"`
+	Byes           bool           `gorm:"not null;default:false"`
+	ByeScore       int            `gorm:"not null;default:0"`
+	TableLayout    TableLayout    `gorm:"type:varchar(255);not null;default:''"`
+	Owners         []*GameOwner   `gorm:"foreignKey:GameID;constraint:OnDelete:CASCADE"`
+	Teams          []*Team        `gorm:"foreignKey:GameID"`
+	Players        []*Player      `gorm:"foreignKey:GameID"`
+	Rounds         []*Round       `gorm:"foreignKey:GameID"`
+	Final          *Final         `gorm:"foreignKey:GameID"`
+	Stages         []*Stage       `gorm:"foreignKey:GameID"`
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 }
 
+// AllPlayers lists the players of all teams of a game followed by the players of an individual game.
+func (g Game) AllPlayers() []*Player {
+	var players []*Player
+
+	for _, team := range g.Teams {
+		players = append(players, team.Players...)
+	}
+
+	return append(players, g.Players...)
+}
+
 type GameOwner struct {
 	GameID   int    `gorm:"primaryKey"`
 	OwnerSub string `gorm:"primaryKey;size:255;not null"`
@@ -51,36 +241,80 @@ type Team struct {
 	UpdatedAt time.Time
 }
 
+// Player belongs to a team, or directly to the game in individual games.
 type Player struct {
-	ID        int      `gorm:"primaryKey"`
-	Name      string   `gorm:"column:player_name;size:255;not null"`
-	TeamID    int      `gorm:"not null"`
-	Team      *Team    `gorm:"foreignKey:TeamID"`
-	Scores    []*Score `gorm:"foreignKey:PlayerID"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID         int      `gorm:"primaryKey"`
+	Name       string   `gorm:"column:player_name;size:255;not null"`
+	TeamID     *int     `gorm:"default:null"`
+	Team       *Team    `gorm:"foreignKey:TeamID"`
+	GameID     *int     `gorm:"default:null"`
+	Game       *Game    `gorm:"foreignKey:GameID"`
+	Scores     []*Score `gorm:"foreignKey:PlayerID"`
+	Substitute bool     `gorm:"not null;default:false"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
 }
 
 type Round struct {
-	ID          int          `gorm:"primaryKey"`
-	RoundNumber int          `gorm:"not null;uniqueIndex:idx_game_round"`
-	GameID      int          `gorm:"not null;uniqueIndex:idx_game_round"`
-	Status      string       `gorm:"size:50;not null"`
-	Tables      []*GameTable `gorm:"foreignKey:RoundID"`
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID            int             `gorm:"primaryKey"`
+	RoundNumber   int             `gorm:"not null;uniqueIndex:idx_game_round"`
+	GameID        int             `gorm:"not null;uniqueIndex:idx_game_round"`
+	StageID       *int            `gorm:"default:null"`
+	Status        RoundStatus     `gorm:"size:50;not null"`
+	Tables        []*GameTable    `gorm:"foreignKey:RoundID"`
+	Byes          []*RoundBye     `gorm:"foreignKey:RoundID"`
+	Substitutions []*Substitution `gorm:"foreignKey:RoundID"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// RoundBye records a player sitting out a round.
+type RoundBye struct {
+	RoundID  int `gorm:"primaryKey"`
+	PlayerID int `gorm:"primaryKey"`
+}
+
+// Substitution records a seated player replaced by a teammate at a table of one round.
+type Substitution struct {
+	ID           int        `gorm:"primaryKey"`
+	RoundID      int        `gorm:"not null"`
+	Round        *Round     `gorm:"foreignKey:RoundID"`
+	TableID      int        `gorm:"column:game_table_id;not null"`
+	Table        *GameTable `gorm:"foreignKey:TableID"`
+	PlayerID     int        `gorm:"not null"`
+	SubstituteID int        `gorm:"not null"`
+	CreatedAt    time.Time
 }
 
 type GameTable struct {
-	ID          int       `gorm:"primaryKey"`
-	TableNumber int       `gorm:"not null;uniqueIndex:idx_round_table"`
-	RoundID     int       `gorm:"not null;uniqueIndex:idx_round_table"`
-	Players     []*Player `gorm:"many2many:table_players"`
-	Scores      []*Score  `gorm:"foreignKey:TableID"`
+	ID          int            `gorm:"primaryKey"`
+	TableNumber int            `gorm:"not null;uniqueIndex:idx_round_table"`
+	RoundID     int            `gorm:"not null;uniqueIndex:idx_round_table"`
+	StageID     *int           `gorm:"default:null"`
+	Capacity    int            `gorm:"not null;default:0"`
+	Round       *Round         `gorm:"foreignKey:RoundID"`
+	Players     []*Player      `gorm:"many2many:table_players"`
+	Seats       []*TablePlayer `gorm:"foreignKey:TableID"`
+	Scores      []*Score       `gorm:"foreignKey:TableID"`
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
 
+// SeatedPlayers returns the players of the table in seat order, players with equal seats ordered by ID.
+func (t GameTable) SeatedPlayers() []*Player {
+	seats := make(map[int]int, len(t.Seats))
+	for _, seat := range t.Seats {
+		seats[seat.PlayerID] = seat.Seat
+	}
+
+	players := slices.Clone(t.Players)
+	slices.SortFunc(players, func(a, b *Player) int {
+		return cmp.Or(cmp.Compare(seats[a.ID], seats[b.ID]), cmp.Compare(a.ID, b.ID))
+	})
+
+	return players
+}
+
 func (GameTable) TableName() string {
 	return "game_tables"
 }
@@ -94,11 +328,113 @@ type Score struct {
 	UpdatedAt time.Time
 }
 
+// ScoreHistory is an append-only record of a single score write. It is keyed by the game, round and
+// table number and keeps the name of the player so that it survives redrawing the tables and deleting
+// the player; TableID and PlayerID are cleared once the table or the player is deleted.
+type ScoreHistory struct {
+	ID          int     `gorm:"primaryKey"`
+	TableID     *int    `gorm:"default:null"`
+	GameID      int     `gorm:"not null"`
+	RoundNumber int     `gorm:"not null"`
+	TableNumber int     `gorm:"not null"`
+	PlayerID    *int    `gorm:"default:null"`
+	PlayerName  string  `gorm:"size:255;not null"`
+	OldScore    *int    `gorm:"column:old_score"`
+	NewScore    int     `gorm:"not null"`
+	OwnerSub    string  `gorm:"size:255;not null"`
+	Reason      *string `gorm:"column:reason"`
+	CreatedAt   time.Time
+}
+
+func (ScoreHistory) TableName() string {
+	return "score_history"
+}
+
+// TablePlayer seats a player at a table. The player in the lowest seat starts.
 type TablePlayer struct {
 	TableID  int `gorm:"primaryKey;column:game_table_id"`
 	PlayerID int `gorm:"primaryKey;column:player_id"`
+	Seat     int `gorm:"not null;default:0"`
 }
 
 func (TablePlayer) TableName() string {
 	return "table_players"
 }
+
+// Final is the finals stage of a game. The top players of the preliminary rounds play one or more
+// final tables whose scores are kept apart from the round scores.
+type Final struct {
+	ID        int           `gorm:"primaryKey"`
+	GameID    int           `gorm:"not null;uniqueIndex"`
+	Finalists int           `gorm:"not null"`
+	Status    FinalStatus   `gorm:"size:50;not null"`
+	Tables    []*FinalTable `gorm:"foreignKey:FinalID"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type FinalTable struct {
+	ID          int            `gorm:"primaryKey"`
+	FinalID     int            `gorm:"not null;uniqueIndex:idx_final_table"`
+	TableNumber int            `gorm:"not null;uniqueIndex:idx_final_table"`
+	Players     []*FinalPlayer `gorm:"foreignKey:FinalTableID"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Scored reports whether every finalist of the table has a score.
+func (t FinalTable) Scored() bool {
+	for _, player := range t.Players {
+		if player.Score == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FinalPlayer seats a finalist at a final table. Seed is the place of the finalist in the preliminary
+// standings, and Score stays nil until the table has been scored.
+type FinalPlayer struct {
+	FinalTableID int `gorm:"primaryKey"`
+	PlayerID     int `gorm:"primaryKey"`
+	Seed         int `gorm:"not null"`
+	Score        *int
+}
+
+// Stage is one step of a multi-stage game. Stages are played in order of their position, every stage
+// with its own pairing mode and number of rounds. The players of a stage qualify from the previous stage.
+type Stage struct {
+	ID             int               `gorm:"primaryKey"`
+	GameID         int               `gorm:"not null;uniqueIndex:idx_game_stage"`
+	Position       int               `gorm:"not null;uniqueIndex:idx_game_stage"`
+	Name           string            `gorm:"column:stage_name;size:255;not null"`
+	PairingMode    PairingMode       `gorm:"size:50;not null;default:random"`
+	NumberOfRounds int               `gorm:"not null"`
+	Groups         int               `gorm:"column:group_count;not null;default:1"`
+	Qualification  QualificationRule `gorm:"size:50;not null;default:all"`
+	Qualifiers     int               `gorm:"not null;default:0"`
+	CarryOver      CarryOver         `gorm:"size:50;not null;default:none"`
+	Status         StageStatus       `gorm:"size:50;not null;default:pending"`
+	Players        []*StagePlayer    `gorm:"foreignKey:StageID"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// StagePlayer records a player taking part in a stage, the group the player is drawn in and the score
+// carried over from the previous stage.
+type StagePlayer struct {
+	StageID      int `gorm:"primaryKey"`
+	PlayerID     int `gorm:"primaryKey"`
+	GroupNumber  int `gorm:"not null;default:1"`
+	CarriedScore int `gorm:"not null;default:0"`
+}
+
+// GameShare unlocks a public read-only view of a game for everyone knowing its token. A game has at most
+// one share; sharing it again replaces the token.
+type GameShare struct {
+	GameID          int    `gorm:"primaryKey;autoIncrement:false"`
+	Token           string `gorm:"size:64;not null;uniqueIndex"`
+	AbbreviateNames bool   `gorm:"not null;default:false"`
+	CreatedAt       time.Time
+}
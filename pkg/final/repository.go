@@ -0,0 +1,64 @@
+package final
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+type FinalsRepository struct {
+	db *gorm.DB
+}
+
+func NewFinalsRepository(db *gorm.DB) *FinalsRepository {
+	return &FinalsRepository{db}
+}
+
+func (r *FinalsRepository) CreateFinal(ctx context.Context, final *entity.Final) (entity.Final, error) {
+	if err := r.db.WithContext(ctx).Create(final).Error; err != nil {
+		return entity.Final{}, err
+	}
+
+	return r.FindFinal(ctx, final.ID)
+}
+
+func (r *FinalsRepository) FindFinal(ctx context.Context, id int) (entity.Final, error) {
+	var final entity.Final
+
+	err := r.db.WithContext(ctx).
+		Preload("Tables", func(db *gorm.DB) *gorm.DB { return db.Order("table_number") }).
+		Preload("Tables.Players", func(db *gorm.DB) *gorm.DB { return db.Order("seed") }).
+		First(&final, id).Error
+	if err != nil {
+		return entity.Final{}, err
+	}
+
+	return final, nil
+}
+
+func (r *FinalsRepository) UpdateStatus(ctx context.Context, finalID int, status entity.FinalStatus) error {
+	return r.db.WithContext(ctx).Model(&entity.Final{ID: finalID}).Update("status", status).Error
+}
+
+// UpdateScores sets the final scores of the finalists of a table, keyed by player ID.
+func (r *FinalsRepository) UpdateScores(ctx context.Context, tableID int, scores map[int]int) error {
+	for playerID, score := range scores {
+		err := r.db.WithContext(ctx).Model(&entity.FinalPlayer{}).
+			Where("final_table_id = ? AND player_id = ?", tableID, playerID).
+			Update("score", score).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *FinalsRepository) WithinTransaction(ctx context.Context, operation func(ctx context.Context, txRepo *FinalsRepository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &FinalsRepository{db: tx}
+		return operation(ctx, txRepo)
+	})
+}
@@ -0,0 +1,174 @@
+package final
+
+import (
+	"context"
+
+	"github.com/henok321/knobel-manager-service/gen/api"
+	"github.com/henok321/knobel-manager-service/pkg/apperror"
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+	"github.com/henok321/knobel-manager-service/pkg/game"
+	"github.com/henok321/knobel-manager-service/pkg/setup"
+	"github.com/henok321/knobel-manager-service/pkg/standings"
+)
+
+type FinalsService struct {
+	repo         *FinalsRepository
+	gamesService *game.GamesService
+}
+
+func NewFinalsService(repo *FinalsRepository, gamesService *game.GamesService) *FinalsService {
+	return &FinalsService{repo: repo, gamesService: gamesService}
+}
+
+// CreateFinal seeds the best players of the preliminary standings into final tables once every
+// preliminary round is completed. The finalists are spread over as few tables as the table size of
+// the game allows. A game has at most one final.
+func (s *FinalsService) CreateFinal(ctx context.Context, gameID int, sub string, finalists int) (entity.Final, error) {
+	gameByID, err := s.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return entity.Final{}, err
+	}
+
+	if gameByID.Final != nil {
+		return entity.Final{}, apperror.ErrFinalExists
+	}
+
+	if len(gameByID.Rounds) < game.PlannedRounds(gameByID) {
+		return entity.Final{}, apperror.ErrPreliminariesOpen
+	}
+
+	for _, round := range gameByID.Rounds {
+		if round.Status != entity.RoundStatusCompleted {
+			return entity.Final{}, apperror.ErrPreliminariesOpen
+		}
+	}
+
+	ranking := standings.Calculate(gameByID, 0).Players
+
+	if finalists < 2 || finalists > len(ranking) {
+		return entity.Final{}, apperror.ErrInvalidFinalists
+	}
+
+	seeded := make([]int, finalists)
+	seeds := make(map[int]int, finalists)
+
+	for i := range seeded {
+		seeded[i] = ranking[i].PlayerID
+		seeds[ranking[i].PlayerID] = i + 1
+	}
+
+	final := entity.Final{GameID: gameID, Finalists: finalists, Status: entity.FinalStatusInProgress}
+
+	for i, seatedIDs := range setup.SeedFinalTables(seeded, gameByID.TableSize) {
+		table := &entity.FinalTable{TableNumber: i + 1}
+
+		for _, playerID := range seatedIDs {
+			table.Players = append(table.Players, &entity.FinalPlayer{PlayerID: playerID, Seed: seeds[playerID]})
+		}
+
+		final.Tables = append(final.Tables, table)
+	}
+
+	return s.repo.CreateFinal(ctx, &final)
+}
+
+// Final returns the finals stage of a game.
+func (s *FinalsService) Final(ctx context.Context, gameID int, sub string) (entity.Final, error) {
+	gameByID, err := s.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return entity.Final{}, err
+	}
+
+	if gameByID.Final == nil {
+		return entity.Final{}, apperror.ErrFinalOrTableNotFound
+	}
+
+	return s.repo.FindFinal(ctx, gameByID.Final.ID)
+}
+
+// UpdateScores records the final scores of a final table. Every finalist of the table needs a score,
+// and the scores can be changed until the final is completed.
+func (s *FinalsService) UpdateScores(ctx context.Context, gameID, tableNumber int, sub string, scoresRequest api.ScoresRequest) (entity.FinalTable, error) {
+	final, err := s.Final(ctx, gameID, sub)
+	if err != nil {
+		return entity.FinalTable{}, err
+	}
+
+	if final.Status == entity.FinalStatusCompleted {
+		return entity.FinalTable{}, apperror.ErrFinalCompleted
+	}
+
+	table := tableAt(final, tableNumber)
+	if table == nil {
+		return entity.FinalTable{}, apperror.ErrFinalOrTableNotFound
+	}
+
+	seated := make(map[int]bool, len(table.Players))
+	for _, player := range table.Players {
+		seated[player.PlayerID] = true
+	}
+
+	if len(scoresRequest.Scores) != len(seated) {
+		return entity.FinalTable{}, apperror.ErrInvalidScore
+	}
+
+	scores := make(map[int]int, len(seated))
+
+	for _, score := range scoresRequest.Scores {
+		if _, duplicate := scores[score.PlayerID]; duplicate || !seated[score.PlayerID] {
+			return entity.FinalTable{}, apperror.ErrInvalidScore
+		}
+
+		scores[score.PlayerID] = score.Score
+	}
+
+	err = s.repo.WithinTransaction(ctx, func(ctx context.Context, txRepo *FinalsRepository) error {
+		return txRepo.UpdateScores(ctx, table.ID, scores)
+	})
+	if err != nil {
+		return entity.FinalTable{}, err
+	}
+
+	for _, player := range table.Players {
+		score := scores[player.PlayerID]
+		player.Score = &score
+	}
+
+	return *table, nil
+}
+
+// CloseFinal completes the final once every finalist has a score.
+func (s *FinalsService) CloseFinal(ctx context.Context, gameID int, sub string) (entity.Final, error) {
+	final, err := s.Final(ctx, gameID, sub)
+	if err != nil {
+		return entity.Final{}, err
+	}
+
+	if final.Status == entity.FinalStatusCompleted {
+		return entity.Final{}, apperror.ErrFinalCompleted
+	}
+
+	for _, table := range final.Tables {
+		if !table.Scored() {
+			return entity.Final{}, apperror.ErrFinalIncomplete
+		}
+	}
+
+	if err := s.repo.UpdateStatus(ctx, final.ID, entity.FinalStatusCompleted); err != nil {
+		return entity.Final{}, err
+	}
+
+	final.Status = entity.FinalStatusCompleted
+
+	return final, nil
+}
+
+func tableAt(final entity.Final, tableNumber int) *entity.FinalTable {
+	for _, table := range final.Tables {
+		if table.TableNumber == tableNumber {
+			return table
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package game
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/henok321/knobel-manager-service/gen/api"
+	"github.com/henok321/knobel-manager-service/pkg/apperror"
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+// CloneGame creates a new game in setup with the configuration, stages, owners, teams and players of
+// an existing game, leaving out the excluded teams. Rounds, scores, the final and the recorded draw
+// are not copied, and a game breaking ties by coin flip gets a coin flip of its own.
+func (s *GamesService) CloneGame(ctx context.Context, gameID int, sub string, request api.GameCloneRequest) (entity.Game, error) {
+	source, err := s.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return entity.Game{}, err
+	}
+
+	var excludeTeams []int
+	if request.ExcludeTeams != nil {
+		excludeTeams = *request.ExcludeTeams
+	}
+
+	for _, teamID := range excludeTeams {
+		if !slices.ContainsFunc(source.Teams, func(team *entity.Team) bool { return team.ID == teamID }) {
+			return entity.Game{}, apperror.ErrTeamNotFound
+		}
+	}
+
+	clone := cloneGame(source, excludeTeams)
+
+	if request.Name != nil {
+		clone.Name = *request.Name
+	}
+
+	err = s.repo.WithinTransaction(ctx, func(ctx context.Context, txRepo *GamesRepository) error {
+		_, err := txRepo.CreateOrUpdateGame(ctx, &clone)
+		return err
+	})
+	if err != nil {
+		return entity.Game{}, err
+	}
+
+	return s.repo.FindByID(ctx, clone.ID)
+}
+
+// cloneGame copies a game without its IDs, rounds and results, so saving it creates a new game.
+func cloneGame(source entity.Game, excludeTeams []int) entity.Game {
+	clone := entity.Game{
+		Name:           source.Name,
+		TeamSize:       source.TeamSize,
+		TableSize:      source.TableSize,
+		NumberOfRounds: source.NumberOfRounds,
+		Status:         entity.StatusSetup,
+		Mode:           source.Mode,
+		PairingMode:    source.PairingMode,
+		ScoreDirection: source.ScoreDirection,
+		TieBreakers:    slices.Clone(source.TieBreakers),
+		ShortTables:    source.ShortTables,
+		Byes:           source.Byes,
+		ByeScore:       source.ByeScore,
+		TableLayout:    slices.Clone(source.TableLayout),
+	}
+
+	if slices.Contains(clone.TieBreakers, entity.TieBreakerCoinFlip) {
+		clone.CoinFlipSeed = time.Now().UnixNano()
+	}
+
+	for _, owner := range source.Owners {
+		clone.Owners = append(clone.Owners, &entity.GameOwner{OwnerSub: owner.OwnerSub})
+	}
+
+	for _, stage := range source.Stages {
+		clone.Stages = append(clone.Stages, &entity.Stage{
+			Position:       stage.Position,
+			Name:           stage.Name,
+			PairingMode:    stage.PairingMode,
+			NumberOfRounds: stage.NumberOfRounds,
+			Groups:         stage.Groups,
+			Qualification:  stage.Qualification,
+			Qualifiers:     stage.Qualifiers,
+			CarryOver:      stage.CarryOver,
+			Status:         entity.StageStatusPending,
+		})
+	}
+
+	for _, team := range source.Teams {
+		if slices.Contains(excludeTeams, team.ID) {
+			continue
+		}
+
+		clone.Teams = append(clone.Teams, &entity.Team{Name: team.Name, Players: clonePlayers(team.Players)})
+	}
+
+	clone.Players = clonePlayers(source.Players)
+
+	return clone
+}
+
+func clonePlayers(players []*entity.Player) []*entity.Player {
+	var clones []*entity.Player
+
+	for _, player := range players {
+		clones = append(clones, &entity.Player{Name: player.Name, Substitute: player.Substitute})
+	}
+
+	return clones
+}
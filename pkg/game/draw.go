@@ -0,0 +1,245 @@
+package game
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/henok321/knobel-manager-service/pkg/apperror"
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+	"github.com/henok321/knobel-manager-service/pkg/setup"
+)
+
+type DrawMismatch struct {
+	RoundNumber int
+	TableNumber int
+}
+
+type DrawVerification struct {
+	Seed       int64
+	Algorithm  string
+	Matches    bool
+	Mismatches []DrawMismatch
+}
+
+// DrawPreview is a table draw of the game setup that has not been persisted yet. The token identifies
+// the drawn tables and byes.
+type DrawPreview struct {
+	Seed      int64
+	Algorithm string
+	Token     string
+	Quality   setup.Quality
+	Rounds    []entity.Round
+}
+
+// PreviewDraw runs the table draw of the game setup like AssignTables, from the given seed or a
+// generated one, without persisting anything.
+func (s *GamesService) PreviewDraw(game entity.Game, seed *int64) (DrawPreview, error) {
+	drawSeed := time.Now().Unix()
+	if seed != nil {
+		drawSeed = *seed
+	}
+
+	rounds, quality, err := drawRounds(game, drawSeed)
+	if err != nil {
+		return DrawPreview{}, err
+	}
+
+	preview := DrawPreview{
+		Seed:      drawSeed,
+		Algorithm: setup.Algorithm,
+		Token:     drawToken(NewTeamSetup(game), drawSeed, rounds),
+		Quality:   quality,
+		Rounds:    make([]entity.Round, len(rounds)),
+	}
+
+	players := map[int]*entity.Player{}
+
+	for _, player := range game.AllPlayers() {
+		players[player.ID] = player
+	}
+
+	teamSetup := NewTeamSetup(game)
+
+	for i, tables := range rounds {
+		round := entity.Round{RoundNumber: i + 1, GameID: game.ID, Status: entity.RoundStatusSetup}
+
+		for tableNumber := range len(tables) {
+			table := &entity.GameTable{TableNumber: tableNumber + 1, Capacity: teamSetup.Seats(tableNumber)}
+			for _, player := range tables[tableNumber] {
+				table.Players = append(table.Players, players[player.ID])
+			}
+
+			round.Tables = append(round.Tables, table)
+		}
+
+		for _, playerID := range setup.Byes(teamSetup, tables) {
+			round.Byes = append(round.Byes, &entity.RoundBye{PlayerID: playerID})
+		}
+
+		preview.Rounds[i] = round
+	}
+
+	return preview, nil
+}
+
+// AcceptDraw persists the draw of a preview. The draw is run again from the seed of the preview and
+// only persisted if it still yields the previewed tables, which is no longer the case once the teams
+// or the settings of the game have changed.
+func (s *GamesService) AcceptDraw(ctx context.Context, game entity.Game, seed int64, token string) error {
+	rounds, _, err := drawRounds(game, seed)
+	if err != nil {
+		return err
+	}
+
+	if drawToken(NewTeamSetup(game), seed, rounds) != token {
+		return apperror.ErrPreviewOutdated
+	}
+
+	return s.persistDraw(ctx, game, rounds, seed)
+}
+
+// drawToken hashes the seed, the algorithm, the seated players of every table and the byes of a draw.
+func drawToken(teamSetup setup.TeamSetup, seed int64, rounds []setup.TeamsPlayersMapping) string {
+	hash := sha256.New()
+
+	_, _ = fmt.Fprintf(hash, "%s:%d", setup.Algorithm, seed)
+
+	for i, tables := range rounds {
+		for tableNumber := range len(tables) {
+			_, _ = fmt.Fprintf(hash, ";%d.%d:", i+1, tableNumber+1)
+
+			for _, player := range tables[tableNumber] {
+				_, _ = fmt.Fprintf(hash, "%d,", player.ID)
+			}
+		}
+
+		_, _ = fmt.Fprintf(hash, ";%d.byes:%v", i+1, setup.Byes(teamSetup, tables))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// VerifyDraw re-runs the table draw from the seed recorded on the game and compares every drawn table
+// with the players assigned to the persisted table of the same number. Substituted players count as
+// the players they replaced.
+func (s *GamesService) VerifyDraw(ctx context.Context, gameID int, sub string) (DrawVerification, error) {
+	game, err := s.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return DrawVerification{}, err
+	}
+
+	if game.DrawSeed == nil || game.DrawAlgorithm == nil || *game.DrawAlgorithm != setup.Algorithm {
+		return DrawVerification{}, apperror.ErrDrawNotReproducible
+	}
+
+	rounds, _, err := setup.AssignRounds(NewTeamSetup(game), roundsAtSetup(game), *game.DrawSeed)
+	if err != nil {
+		return DrawVerification{}, apperror.ErrDrawNotReproducible
+	}
+
+	mismatches := compareDraw(game, rounds)
+
+	return DrawVerification{
+		Seed:       *game.DrawSeed,
+		Algorithm:  *game.DrawAlgorithm,
+		Matches:    len(mismatches) == 0,
+		Mismatches: mismatches,
+	}, nil
+}
+
+// SetupReport analyses the persisted tables of all rounds of a game.
+func (s *GamesService) SetupReport(ctx context.Context, gameID int, sub string) (setup.Report, error) {
+	game, err := s.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return setup.Report{}, err
+	}
+
+	rounds := make([]setup.RoundTables, 0, len(game.Rounds))
+
+	for _, round := range game.Rounds {
+		tables := setup.TeamsPlayersMapping{}
+
+		for _, table := range round.Tables {
+			for _, player := range table.Players {
+				tables[table.TableNumber] = append(tables[table.TableNumber], DrawPlayer(player))
+			}
+		}
+
+		rounds = append(rounds, setup.RoundTables{RoundNumber: round.RoundNumber, Tables: tables})
+	}
+
+	return setup.NewReport(teamsMap(game), rounds), nil
+}
+
+func compareDraw(game entity.Game, rounds []setup.TeamsPlayersMapping) []DrawMismatch {
+	persisted := map[DrawMismatch][]int{}
+
+	for _, round := range game.Rounds {
+		if round.RoundNumber > len(rounds) {
+			continue
+		}
+
+		substitutions := slices.Clone(round.Substitutions)
+		slices.SortFunc(substitutions, func(a, b *entity.Substitution) int { return b.ID - a.ID })
+
+		for _, table := range round.Tables {
+			playerIDs := make([]int, 0, len(table.Players))
+			for _, player := range table.Players {
+				playerIDs = append(playerIDs, player.ID)
+			}
+
+			for _, substitution := range substitutions {
+				if substitution.TableID != table.ID {
+					continue
+				}
+
+				if i := slices.Index(playerIDs, substitution.SubstituteID); i >= 0 {
+					playerIDs[i] = substitution.PlayerID
+				}
+			}
+
+			slices.Sort(playerIDs)
+			persisted[DrawMismatch{round.RoundNumber, table.TableNumber}] = playerIDs
+		}
+	}
+
+	mismatches := []DrawMismatch{}
+
+	for i, tables := range rounds {
+		for tableIndex := range len(tables) {
+			key := DrawMismatch{RoundNumber: i + 1, TableNumber: tableIndex + 1}
+
+			playerIDs := make([]int, 0, len(tables[tableIndex]))
+			for _, player := range tables[tableIndex] {
+				playerIDs = append(playerIDs, player.ID)
+			}
+
+			slices.Sort(playerIDs)
+
+			assigned, ok := persisted[key]
+			if !ok || !slices.Equal(assigned, playerIDs) {
+				mismatches = append(mismatches, key)
+			}
+
+			delete(persisted, key)
+		}
+	}
+
+	for key := range persisted {
+		mismatches = append(mismatches, key)
+	}
+
+	slices.SortFunc(mismatches, func(a, b DrawMismatch) int {
+		if a.RoundNumber != b.RoundNumber {
+			return a.RoundNumber - b.RoundNumber
+		}
+
+		return a.TableNumber - b.TableNumber
+	})
+
+	return mismatches
+}
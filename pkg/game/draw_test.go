@@ -0,0 +1,40 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+func TestPreviewDrawIndividualGame(t *testing.T) {
+	gameID := 1
+	game := entity.Game{ID: gameID, Mode: entity.GameModeIndividual, TeamSize: 1, TableSize: 4, NumberOfRounds: 2}
+
+	for id := 1; id <= 8; id++ {
+		game.Players = append(game.Players, &entity.Player{ID: id, GameID: &gameID})
+	}
+
+	seed := int64(42)
+
+	preview, err := (&GamesService{}).PreviewDraw(game, &seed)
+	require.NoError(t, err)
+	require.Len(t, preview.Rounds, 2)
+
+	for _, round := range preview.Rounds {
+		seated := 0
+
+		for _, table := range round.Tables {
+			for _, player := range table.Players {
+				require.NotNil(t, player, "every seated player is resolved")
+				assert.Equal(t, &gameID, player.GameID)
+			}
+
+			seated += len(table.Players)
+		}
+
+		assert.Equal(t, 8, seated)
+	}
+}
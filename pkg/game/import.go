@@ -0,0 +1,188 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/henok321/knobel-manager-service/pkg/apperror"
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+// ImportGame creates a game read from an archive with the caller as its only owner. The IDs of the
+// archived game only link its parts: teams, players, stages, rounds, tables, seats, scores, byes,
+// substitutions and the final are all created with fresh IDs in one transaction. The draw seed is not
+// imported: the draw depends on the IDs of the players, so it cannot be verified against the new ones.
+func (s *GamesService) ImportGame(ctx context.Context, sub string, archived entity.Game) (entity.Game, error) {
+	imported := cloneGame(archived, nil)
+	imported.Owners = []*entity.GameOwner{{OwnerSub: sub}}
+	imported.Status = archived.Status
+	imported.CoinFlipSeed = archived.CoinFlipSeed
+
+	for i, stage := range archived.Stages {
+		imported.Stages[i].Status = stage.Status
+	}
+
+	err := s.repo.WithinTransaction(ctx, func(ctx context.Context, txRepo *GamesRepository) error {
+		if _, err := txRepo.CreateOrUpdateGame(ctx, &imported); err != nil {
+			return fmt.Errorf("cannot create game: %w", err)
+		}
+
+		playerIDs := map[int]int{}
+		importedPlayers := imported.AllPlayers()
+
+		for i, player := range archived.AllPlayers() {
+			playerIDs[player.ID] = importedPlayers[i].ID
+		}
+
+		stageIDs := map[int]int{}
+
+		var stagePlayers []entity.StagePlayer
+
+		for i, stage := range archived.Stages {
+			stageIDs[stage.ID] = imported.Stages[i].ID
+
+			for _, stagePlayer := range stage.Players {
+				stagePlayers = append(stagePlayers, entity.StagePlayer{
+					StageID:      imported.Stages[i].ID,
+					PlayerID:     playerIDs[stagePlayer.PlayerID],
+					GroupNumber:  stagePlayer.GroupNumber,
+					CarriedScore: stagePlayer.CarriedScore,
+				})
+			}
+		}
+
+		if len(stagePlayers) > 0 {
+			if err := txRepo.CreateStagePlayers(ctx, stagePlayers); err != nil {
+				return fmt.Errorf("cannot create stage players: %w", err)
+			}
+		}
+
+		for _, round := range archived.Rounds {
+			if err := importRound(ctx, txRepo, imported.ID, *round, playerIDs, stageIDs); err != nil {
+				return err
+			}
+		}
+
+		if archived.Final != nil {
+			if err := txRepo.CreateFinal(ctx, importedFinal(imported.ID, *archived.Final, playerIDs)); err != nil {
+				return fmt.Errorf("cannot create final: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.Game{}, err
+	}
+
+	return s.repo.FindByID(ctx, imported.ID)
+}
+
+// importRound persists an archived round with its tables, seats, scores, byes and substitutions,
+// replacing the archived player and stage IDs by the imported ones.
+func importRound(ctx context.Context, txRepo *GamesRepository, gameID int, archived entity.Round, playerIDs, stageIDs map[int]int) error {
+	round := entity.Round{
+		RoundNumber: archived.RoundNumber,
+		GameID:      gameID,
+		StageID:     importedStageID(stageIDs, archived.StageID),
+		Status:      archived.Status,
+	}
+
+	round, err := txRepo.CreateRound(ctx, &round)
+	if err != nil {
+		return fmt.Errorf("cannot create round: %w", err)
+	}
+
+	tables := make([]entity.GameTable, len(archived.Tables))
+
+	for i, table := range archived.Tables {
+		tables[i] = entity.GameTable{
+			TableNumber: table.TableNumber,
+			RoundID:     round.ID,
+			StageID:     importedStageID(stageIDs, table.StageID),
+			Capacity:    table.Capacity,
+		}
+
+		for _, seat := range table.Seats {
+			tables[i].Seats = append(tables[i].Seats, &entity.TablePlayer{PlayerID: playerIDs[seat.PlayerID], Seat: seat.Seat})
+		}
+
+		for _, score := range table.Scores {
+			tables[i].Scores = append(tables[i].Scores, &entity.Score{PlayerID: playerIDs[score.PlayerID], Score: score.Score})
+		}
+	}
+
+	if len(tables) > 0 {
+		if err := txRepo.CreateGameTables(ctx, tables); err != nil {
+			return fmt.Errorf("cannot create game tables: %w", err)
+		}
+	}
+
+	if len(archived.Byes) > 0 {
+		roundByes := make([]entity.RoundBye, len(archived.Byes))
+		for i, bye := range archived.Byes {
+			roundByes[i] = entity.RoundBye{RoundID: round.ID, PlayerID: playerIDs[bye.PlayerID]}
+		}
+
+		if err := txRepo.CreateRoundByes(ctx, roundByes); err != nil {
+			return fmt.Errorf("cannot create round byes: %w", err)
+		}
+	}
+
+	if len(archived.Substitutions) > 0 {
+		substitutions := make([]entity.Substitution, len(archived.Substitutions))
+
+		for i, substitution := range archived.Substitutions {
+			tableIndex := slices.IndexFunc(archived.Tables, func(table *entity.GameTable) bool {
+				return table == substitution.Table || substitution.Table == nil && table.ID == substitution.TableID
+			})
+			if tableIndex < 0 {
+				return apperror.ErrInvalidArchive
+			}
+
+			substitutions[i] = entity.Substitution{
+				RoundID:      round.ID,
+				TableID:      tables[tableIndex].ID,
+				PlayerID:     playerIDs[substitution.PlayerID],
+				SubstituteID: playerIDs[substitution.SubstituteID],
+			}
+		}
+
+		if err := txRepo.CreateSubstitutions(ctx, substitutions); err != nil {
+			return fmt.Errorf("cannot create substitutions: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func importedFinal(gameID int, archived entity.Final, playerIDs map[int]int) *entity.Final {
+	final := &entity.Final{GameID: gameID, Finalists: archived.Finalists, Status: archived.Status}
+
+	for _, table := range archived.Tables {
+		finalTable := &entity.FinalTable{TableNumber: table.TableNumber}
+
+		for _, player := range table.Players {
+			finalTable.Players = append(finalTable.Players, &entity.FinalPlayer{
+				PlayerID: playerIDs[player.PlayerID],
+				Seed:     player.Seed,
+				Score:    player.Score,
+			})
+		}
+
+		final.Tables = append(final.Tables, finalTable)
+	}
+
+	return final
+}
+
+func importedStageID(stageIDs map[int]int, stageID *int) *int {
+	if stageID == nil {
+		return nil
+	}
+
+	importedID := stageIDs[*stageID]
+
+	return &importedID
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/henok321/knobel-manager-service/pkg/entity"
 )
@@ -22,8 +23,12 @@ func (r *GamesRepository) FindAllByOwner(ctx context.Context, sub string) ([]ent
 	err := r.db.WithContext(ctx).
 		Joins("JOIN game_owners ON game_owners.game_id = games.id").Where("game_owners.owner_sub = ?", sub).
 		Preload("Teams.Players.Scores").
+		Preload("Players").
 		Preload("Rounds.Tables.Players").
+		Preload("Rounds.Tables.Seats").
 		Preload("Rounds.Tables.Scores").
+		Preload("Rounds.Byes").
+		Preload("Rounds.Substitutions").
 		Preload("Owners").
 		Find(&games).Error
 	if err != nil {
@@ -39,8 +44,15 @@ func (r *GamesRepository) FindByID(ctx context.Context, id int) (entity.Game, er
 	err := r.db.WithContext(ctx).
 		Where("games.id = ?", id).
 		Preload("Teams.Players.Scores").
+		Preload("Players").
 		Preload("Rounds.Tables.Players").
+		Preload("Rounds.Tables.Seats").
 		Preload("Rounds.Tables.Scores").
+		Preload("Rounds.Byes").
+		Preload("Rounds.Substitutions").
+		Preload("Final.Tables.Players").
+		Preload("Stages", func(db *gorm.DB) *gorm.DB { return db.Order("stages.position") }).
+		Preload("Stages.Players").
 		Preload("Owners").
 		First(&game).Error
 	if err != nil {
@@ -92,7 +104,15 @@ func (r *GamesRepository) CreateGameTables(ctx context.Context, gameTables []ent
 	return r.db.WithContext(ctx).Save(gameTables).Error
 }
 
+func (r *GamesRepository) CreateRoundByes(ctx context.Context, roundByes []entity.RoundBye) error {
+	return r.db.WithContext(ctx).Create(roundByes).Error
+}
+
 func (r *GamesRepository) ResetGameTables(ctx context.Context, gameID int) error {
+	if err := r.db.WithContext(ctx).Where("game_id = ?", gameID).Delete(&entity.Final{}).Error; err != nil {
+		return err
+	}
+
 	var roundIDs []int
 	if err := r.db.WithContext(ctx).Model(&entity.Round{}).Where("game_id = ?", gameID).Pluck("id", &roundIDs).Error; err != nil {
 		return err
@@ -117,6 +137,10 @@ func (r *GamesRepository) ResetGameTables(ctx context.Context, gameID int) error
 		if err := r.db.WithContext(ctx).Where("round_id IN ?", roundIDs).Delete(&entity.GameTable{}).Error; err != nil {
 			return err
 		}
+
+		if err := r.db.WithContext(ctx).Where("round_id IN ?", roundIDs).Delete(&entity.RoundBye{}).Error; err != nil {
+			return err
+		}
 	}
 
 	if err := r.db.WithContext(ctx).Where("game_id = ?", gameID).Delete(&entity.Round{}).Error; err != nil {
@@ -126,6 +150,69 @@ func (r *GamesRepository) ResetGameTables(ctx context.Context, gameID int) error
 	return nil
 }
 
+// ResetRoundTables deletes the tables of a round with their seats, scores and substitutions, and the byes of the round.
+func (r *GamesRepository) ResetRoundTables(ctx context.Context, roundID int) error {
+	var tableIDs []int
+	if err := r.db.WithContext(ctx).Model(&entity.GameTable{}).Where("round_id = ?", roundID).Pluck("id", &tableIDs).Error; err != nil {
+		return err
+	}
+
+	if len(tableIDs) > 0 {
+		if err := r.db.WithContext(ctx).Where("table_id IN ?", tableIDs).Delete(&entity.Score{}).Error; err != nil {
+			return err
+		}
+
+		if err := r.db.WithContext(ctx).Where("game_table_id IN ?", tableIDs).Delete(&entity.TablePlayer{}).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := r.db.WithContext(ctx).Where("round_id = ?", roundID).Delete(&entity.Substitution{}).Error; err != nil {
+		return err
+	}
+
+	if err := r.db.WithContext(ctx).Where("round_id = ?", roundID).Delete(&entity.GameTable{}).Error; err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Where("round_id = ?", roundID).Delete(&entity.RoundBye{}).Error
+}
+
+// ReplaceStages deletes the stages of a game and creates the given ones instead.
+func (r *GamesRepository) ReplaceStages(ctx context.Context, gameID int, stages []entity.Stage) error {
+	if err := r.db.WithContext(ctx).Where("game_id = ?", gameID).Delete(&entity.Stage{}).Error; err != nil {
+		return err
+	}
+
+	if len(stages) == 0 {
+		return nil
+	}
+
+	return r.db.WithContext(ctx).Create(&stages).Error
+}
+
+func (r *GamesRepository) UpdateStageStatus(ctx context.Context, stageID int, status entity.StageStatus) error {
+	return r.db.WithContext(ctx).Model(&entity.Stage{}).Where("id = ?", stageID).Update("status", status).Error
+}
+
+func (r *GamesRepository) CreateStagePlayers(ctx context.Context, stagePlayers []entity.StagePlayer) error {
+	return r.db.WithContext(ctx).Create(stagePlayers).Error
+}
+
+func (r *GamesRepository) CreateSubstitutions(ctx context.Context, substitutions []entity.Substitution) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Create(substitutions).Error
+}
+
+func (r *GamesRepository) CreateFinal(ctx context.Context, final *entity.Final) error {
+	return r.db.WithContext(ctx).Create(final).Error
+}
+
+func (r *GamesRepository) UpdateDraw(ctx context.Context, gameID int, seed int64, algorithm string) error {
+	return r.db.WithContext(ctx).Model(&entity.Game{}).
+		Where("id = ?", gameID).
+		Updates(map[string]any{"draw_seed": seed, "draw_algorithm": algorithm}).Error
+}
+
 func (r *GamesRepository) WithinTransaction(ctx context.Context, operation func(ctx context.Context, txRepo *GamesRepository) error) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		txRepo := &GamesRepository{db: tx}
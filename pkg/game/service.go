@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"gorm.io/gorm"
@@ -53,6 +54,32 @@ func (s *GamesService) CreateGame(ctx context.Context, sub string, game *api.Gam
 		NumberOfRounds: game.NumberOfRounds,
 		Owners:         []*entity.GameOwner{{OwnerSub: sub}},
 		Status:         entity.StatusSetup,
+		Mode:           entity.GameModeTeam,
+		PairingMode:    entity.PairingRandom,
+		ScoreDirection: entity.HigherWins,
+		TieBreakers:    entity.TieBreakers{},
+	}
+
+	if game.PairingMode != nil {
+		gameModel.PairingMode = entity.PairingMode(*game.PairingMode)
+	}
+
+	if game.Mode != nil {
+		gameModel.Mode = entity.GameMode(*game.Mode)
+	}
+
+	if gameModel.Mode == entity.GameModeIndividual {
+		gameModel.TeamSize = 1
+	}
+
+	if game.Seating != nil {
+		gameModel.ShortTables = game.Seating.ShortTables
+		gameModel.Byes = game.Seating.Byes
+		gameModel.ByeScore = game.Seating.ByeScore
+	}
+
+	if game.TableLayout != nil {
+		gameModel.TableLayout = entity.TableLayout(*game.TableLayout)
 	}
 
 	return s.repo.CreateOrUpdateGame(ctx, &gameModel)
@@ -64,25 +91,47 @@ func (s *GamesService) UpdateGame(ctx context.Context, id int, sub string, game
 		return entity.Game{}, err
 	}
 
+	if len(gameByID.Rounds) > 0 && seatingChanged(gameByID, game) {
+		return entity.Game{}, apperror.ErrSeatingLocked
+	}
+
 	gameByID.Name = game.Name
 	gameByID.TeamSize = game.TeamSize
 	gameByID.TableSize = game.TableSize
 	gameByID.NumberOfRounds = game.NumberOfRounds
 
+	if gameByID.Mode == entity.GameModeIndividual {
+		gameByID.TeamSize = 1
+	}
+
 	if game.Status != "" {
 		gameByID.Status = entity.GameStatus(game.Status)
 	}
 
-	if game.Status == "in_progress" {
-		teams := teamsMap(gameByID)
+	if game.PairingMode != nil {
+		gameByID.PairingMode = entity.PairingMode(*game.PairingMode)
+	}
+
+	if game.Ranking != nil {
+		applyRanking(&gameByID, *game.Ranking)
+	}
 
-		if len(gameByID.Rounds) != gameByID.NumberOfRounds {
+	if game.Seating != nil {
+		gameByID.ShortTables = game.Seating.ShortTables
+		gameByID.Byes = game.Seating.Byes
+		gameByID.ByeScore = game.Seating.ByeScore
+	}
+
+	if game.TableLayout != nil {
+		gameByID.TableLayout = entity.TableLayout(*game.TableLayout)
+	}
+
+	if game.Status == "in_progress" {
+		if !roundsDrawn(gameByID) {
 			return entity.Game{}, apperror.ErrInvalidGameSetup
 		}
 
-		validSetup := setup.IsAssignable(teams, gameByID.TeamSize, gameByID.TableSize)
-
-		if !validSetup {
+		if _, validSetup := setup.PlanTables(NewTeamSetup(gameByID)); !validSetup && len(gameByID.Stages) == 0 {
 			return entity.Game{}, apperror.ErrInvalidGameSetup
 		}
 	}
@@ -95,27 +144,104 @@ func (s *GamesService) UpdateGame(ctx context.Context, id int, sub string, game
 	return s.repo.CreateOrUpdateGame(ctx, &gameByID)
 }
 
+// seatingChanged reports whether an update changes the seating or the table layout of a game, which
+// the rounds already drawn were seated by.
+func seatingChanged(game entity.Game, update api.GameUpdateRequest) bool {
+	if update.Seating != nil && (update.Seating.ShortTables != game.ShortTables ||
+		update.Seating.Byes != game.Byes || update.Seating.ByeScore != game.ByeScore) {
+		return true
+	}
+
+	return update.TableLayout != nil && !slices.Equal(*update.TableLayout, []int(game.TableLayout))
+}
+
+// applyRanking sets the ranking configuration of a game. A coin flip seed is generated
+// when the coin_flip tie-breaker is configured without one and none has been recorded yet.
+func applyRanking(game *entity.Game, ranking api.Ranking) {
+	game.ScoreDirection = entity.ScoreDirection(ranking.ScoreDirection)
+	game.TieBreakers = make(entity.TieBreakers, len(ranking.TieBreakers))
+
+	for i, tieBreaker := range ranking.TieBreakers {
+		game.TieBreakers[i] = entity.TieBreaker(tieBreaker)
+	}
+
+	if ranking.CoinFlipSeed != nil {
+		game.CoinFlipSeed = *ranking.CoinFlipSeed
+	} else if game.CoinFlipSeed == 0 && slices.Contains(game.TieBreakers, entity.TieBreakerCoinFlip) {
+		game.CoinFlipSeed = time.Now().UnixNano()
+	}
+}
+
+// roundsDrawn reports whether the setup has drawn the rounds the pairing mode expects before the game starts.
+// A game played in stages starts once the first stage has been drawn.
+func roundsDrawn(game entity.Game) bool {
+	if game.PairingMode == entity.PairingSwiss || len(game.Stages) > 0 {
+		return len(game.Rounds) >= 1
+	}
+
+	return len(game.Rounds) == game.NumberOfRounds
+}
+
+// teamsMap lists the players of every team of a game. Players of an individual game form a team of
+// their own, keyed by their player ID.
 func teamsMap(game entity.Game) map[int][]int {
 	teams := map[int][]int{}
 
-	for _, team := range game.Teams {
-		for _, player := range team.Players {
-			teams[team.ID] = append(teams[team.ID], player.ID)
+	for _, player := range game.AllPlayers() {
+		drawPlayer := DrawPlayer(player)
+		teams[drawPlayer.TeamID] = append(teams[drawPlayer.TeamID], player.ID)
+	}
+
+	return teams
+}
+
+// drawnTeams lists the players of every team taking part in the table draw, leaving out substitutes.
+// Players of an individual game form a team of their own.
+func drawnTeams(game entity.Game) map[int][]int {
+	teams := map[int][]int{}
+
+	for _, player := range game.AllPlayers() {
+		if !player.Substitute {
+			drawPlayer := DrawPlayer(player)
+			teams[drawPlayer.TeamID] = append(teams[drawPlayer.TeamID], player.ID)
 		}
 	}
 
 	return teams
 }
 
+// DrawPlayer returns a player as seen by the table draw. A player of an individual game has no team
+// and counts as a team of their own, identified by the player ID.
+func DrawPlayer(player *entity.Player) setup.Player {
+	if player.TeamID == nil {
+		return setup.Player{ID: player.ID, TeamID: player.ID}
+	}
+
+	return setup.Player{ID: player.ID, TeamID: *player.TeamID}
+}
+
+// gameIncompleteScoresMissing reports whether rounds are still to be drawn or a seated player has no score.
+// Players sitting out or replaced by a substitute need no score for that round.
 func gameIncompleteScoresMissing(game entity.Game) bool {
-	for _, team := range game.Teams {
-		for _, player := range team.Players {
-			scores := len(player.Scores)
-			if scores < game.NumberOfRounds {
-				return true
+	if len(game.Rounds) < PlannedRounds(game) {
+		return true
+	}
+
+	for _, round := range game.Rounds {
+		for _, table := range round.Tables {
+			scored := make(map[int]bool, len(table.Scores))
+			for _, score := range table.Scores {
+				scored[score.PlayerID] = true
+			}
+
+			for _, player := range table.Players {
+				if !scored[player.ID] {
+					return true
+				}
 			}
 		}
 	}
+
 	return false
 }
 
@@ -170,47 +296,218 @@ func (s *GamesService) RemoveOwner(ctx context.Context, gameID int, callerSub, t
 	return s.repo.FindByID(ctx, gameID)
 }
 
-func (s *GamesService) AssignTables(ctx context.Context, game entity.Game) error {
+// AssignTables draws the tables of every round jointly, or only of the first round for swiss games.
+// The draw uses the given seed, or a generated one, and records it on the game so it can be verified.
+func (s *GamesService) AssignTables(ctx context.Context, game entity.Game, seed *int64) error {
+	drawSeed := time.Now().Unix()
+	if seed != nil {
+		drawSeed = *seed
+	}
+
+	rounds, _, err := drawRounds(game, drawSeed)
+	if err != nil {
+		return err
+	}
+
+	return s.persistDraw(ctx, game, rounds, drawSeed)
+}
+
+// drawRounds runs the table draw of the game setup for the given seed without persisting it. The
+// players of every table are ordered by seat, rotating the starting player across the rounds.
+func drawRounds(game entity.Game, seed int64) ([]setup.TeamsPlayersMapping, setup.Quality, error) {
+	if len(game.Stages) > 0 {
+		return nil, setup.Quality{}, apperror.ErrStagedGame
+	}
+
+	teamSetup := NewTeamSetup(game)
+
+	if _, ok := setup.PlanTables(teamSetup); !ok {
+		return nil, setup.Quality{}, apperror.ErrInvalidGameSetup
+	}
+
+	rounds, quality, err := setup.AssignRounds(teamSetup, roundsAtSetup(game), seed)
+	if err != nil {
+		return nil, setup.Quality{}, apperror.ErrTableAssignment
+	}
+
+	starts := map[int]int{}
+	for _, tables := range rounds {
+		setup.AssignSeats(tables, starts)
+	}
+
+	return rounds, quality, nil
+}
+
+// persistDraw replaces all rounds of a game by the drawn rounds and records the seed of the draw.
+func (s *GamesService) persistDraw(ctx context.Context, game entity.Game, rounds []setup.TeamsPlayersMapping, seed int64) error {
+	teamSetup := NewTeamSetup(game)
+
 	return s.repo.WithinTransaction(ctx, func(ctx context.Context, txRepo *GamesRepository) error {
 		if err := txRepo.ResetGameTables(ctx, game.ID); err != nil {
 			return fmt.Errorf("cannot reset game tables: %w", err)
 		}
 
-		teams := teamsMap(game)
-
-		for i := range game.NumberOfRounds {
-			tables, err := setup.AssignTables(setup.TeamSetup{Teams: teams, TeamSize: game.TeamSize, TableSize: game.TableSize}, time.Now().Unix()-(int64(i)*1000))
-			if err != nil {
-				return apperror.ErrTableAssignment
+		for i, tables := range rounds {
+			if _, err := createRound(ctx, txRepo, game.ID, i+1, nil, teamSetup, tables); err != nil {
+				return err
 			}
+		}
 
-			round := entity.Round{
-				RoundNumber: i + 1,
-				GameID:      game.ID,
-			}
+		if err := txRepo.UpdateDraw(ctx, game.ID, seed, setup.Algorithm); err != nil {
+			return fmt.Errorf("cannot record draw seed: %w", err)
+		}
 
-			round, err = txRepo.CreateRound(ctx, &round)
-			if err != nil {
-				return fmt.Errorf("cannot create round: %w", err)
-			}
+		return nil
+	})
+}
 
-			gameTables := make([]entity.GameTable, 0, len(tables))
+// roundsAtSetup returns the number of rounds drawn by the game setup.
+func roundsAtSetup(game entity.Game) int {
+	if game.PairingMode == entity.PairingSwiss {
+		return 1
+	}
 
-			for tableNumber, players := range tables {
-				gameTable := entity.GameTable{TableNumber: tableNumber + 1, RoundID: round.ID}
-				for _, playerID := range players {
-					gameTable.Players = append(gameTable.Players, &entity.Player{ID: playerID.ID})
-				}
+	return game.NumberOfRounds
+}
 
-				gameTables = append(gameTables, gameTable)
-			}
+// CreateRound persists a drawn round with its tables and byes in setup status. The round belongs to
+// the given stage, if any.
+func (s *GamesService) CreateRound(ctx context.Context, gameID, roundNumber int, stageID *int, teamSetup setup.TeamSetup, tables setup.TeamsPlayersMapping) (entity.Round, error) {
+	var round entity.Round
+
+	err := s.repo.WithinTransaction(ctx, func(ctx context.Context, txRepo *GamesRepository) error {
+		var err error
+
+		round, err = createRound(ctx, txRepo, gameID, roundNumber, stageID, teamSetup, tables)
 
-			err = txRepo.CreateGameTables(ctx, gameTables)
-			if err != nil {
-				return fmt.Errorf("cannot create game tables: %w", err)
+		return err
+	})
+
+	return round, err
+}
+
+// RedrawRound replaces the tables and byes of an existing round by the given tables. The substitutions
+// of the round are recorded again at the tables their substitutes are drawn to; substitutes sitting out
+// the round keep no substitution. The round keeps its number and status.
+func (s *GamesService) RedrawRound(ctx context.Context, round entity.Round, teamSetup setup.TeamSetup, tables setup.TeamsPlayersMapping) error {
+	return s.repo.WithinTransaction(ctx, func(ctx context.Context, txRepo *GamesRepository) error {
+		if err := txRepo.ResetRoundTables(ctx, round.ID); err != nil {
+			return fmt.Errorf("cannot reset round tables: %w", err)
+		}
+
+		gameTables, err := createRoundTables(ctx, txRepo, round.ID, round.StageID, teamSetup, tables)
+		if err != nil {
+			return err
+		}
+
+		substitutions := make([]entity.Substitution, 0, len(round.Substitutions))
+
+		for _, substitution := range round.Substitutions {
+			for _, table := range gameTables {
+				if slices.ContainsFunc(table.Seats, func(seat *entity.TablePlayer) bool { return seat.PlayerID == substitution.SubstituteID }) {
+					substitutions = append(substitutions, entity.Substitution{RoundID: round.ID, TableID: table.ID, PlayerID: substitution.PlayerID, SubstituteID: substitution.SubstituteID})
+				}
 			}
 		}
 
+		if len(substitutions) == 0 {
+			return nil
+		}
+
+		if err := txRepo.CreateSubstitutions(ctx, substitutions); err != nil {
+			return fmt.Errorf("cannot create substitutions: %w", err)
+		}
+
 		return nil
 	})
 }
+
+// NewTeamSetup returns the input of the table draw for the teams of a game.
+func NewTeamSetup(game entity.Game) setup.TeamSetup {
+	return setup.TeamSetup{
+		Teams:       drawnTeams(game),
+		TeamSize:    game.TeamSize,
+		TableSize:   game.TableSize,
+		ShortTables: game.ShortTables,
+		Byes:        game.Byes,
+		TableLayout: game.TableLayout,
+	}
+}
+
+// SeatTables orders the players of the drawn tables of a round by seat so that players who started
+// less often at the other rounds of the game start first.
+func SeatTables(game entity.Game, roundNumber int, tables setup.TeamsPlayersMapping) {
+	setup.AssignSeats(tables, startCounts(game, roundNumber))
+}
+
+// startCounts counts the rounds every player of a game has started, leaving out the given round.
+func startCounts(game entity.Game, roundNumber int) map[int]int {
+	starts := map[int]int{}
+
+	for _, round := range game.Rounds {
+		if round.RoundNumber == roundNumber {
+			continue
+		}
+
+		for _, table := range round.Tables {
+			if seated := table.SeatedPlayers(); len(seated) > 0 {
+				starts[seated[0].ID]++
+			}
+		}
+	}
+
+	return starts
+}
+
+// createRound persists a round in setup status together with its tables and byes.
+func createRound(ctx context.Context, txRepo *GamesRepository, gameID, roundNumber int, stageID *int, teamSetup setup.TeamSetup, tables setup.TeamsPlayersMapping) (entity.Round, error) {
+	round := entity.Round{
+		RoundNumber: roundNumber,
+		GameID:      gameID,
+		StageID:     stageID,
+		Status:      entity.RoundStatusSetup,
+	}
+
+	round, err := txRepo.CreateRound(ctx, &round)
+	if err != nil {
+		return entity.Round{}, fmt.Errorf("cannot create round: %w", err)
+	}
+
+	if _, err := createRoundTables(ctx, txRepo, round.ID, stageID, teamSetup, tables); err != nil {
+		return entity.Round{}, err
+	}
+
+	return round, nil
+}
+
+// createRoundTables persists the tables of a round, seating the players in the drawn order, and the
+// players of the setup not seated at any table as byes. It returns the created tables.
+func createRoundTables(ctx context.Context, txRepo *GamesRepository, roundID int, stageID *int, teamSetup setup.TeamSetup, tables setup.TeamsPlayersMapping) ([]entity.GameTable, error) {
+	gameTables := make([]entity.GameTable, 0, len(tables))
+
+	for tableNumber, players := range tables {
+		gameTable := entity.GameTable{TableNumber: tableNumber + 1, RoundID: roundID, StageID: stageID, Capacity: teamSetup.Seats(tableNumber)}
+		for seat, player := range players {
+			gameTable.Seats = append(gameTable.Seats, &entity.TablePlayer{PlayerID: player.ID, Seat: seat + 1})
+		}
+
+		gameTables = append(gameTables, gameTable)
+	}
+
+	if err := txRepo.CreateGameTables(ctx, gameTables); err != nil {
+		return nil, fmt.Errorf("cannot create game tables: %w", err)
+	}
+
+	if byes := setup.Byes(teamSetup, tables); len(byes) > 0 {
+		roundByes := make([]entity.RoundBye, len(byes))
+		for i, playerID := range byes {
+			roundByes[i] = entity.RoundBye{RoundID: roundID, PlayerID: playerID}
+		}
+
+		if err := txRepo.CreateRoundByes(ctx, roundByes); err != nil {
+			return nil, fmt.Errorf("cannot create round byes: %w", err)
+		}
+	}
+
+	return gameTables, nil
+}
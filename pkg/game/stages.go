@@ -0,0 +1,265 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/henok321/knobel-manager-service/pkg/apperror"
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+	"github.com/henok321/knobel-manager-service/pkg/setup"
+)
+
+// PlannedRounds returns the number of rounds a game is played over, summed over the stages of a game
+// played in stages.
+func PlannedRounds(game entity.Game) int {
+	if len(game.Stages) == 0 {
+		return game.NumberOfRounds
+	}
+
+	rounds := 0
+	for _, stage := range game.Stages {
+		rounds += stage.NumberOfRounds
+	}
+
+	return rounds
+}
+
+// CurrentStage returns the stage of a game in progress, or nil if no stage is in progress.
+func CurrentStage(game entity.Game) *entity.Stage {
+	for _, stage := range game.Stages {
+		if stage.Status == entity.StageStatusInProgress {
+			return stage
+		}
+	}
+
+	return nil
+}
+
+// StageRounds returns the rounds of a game drawn for the given stage.
+func StageRounds(game entity.Game, stageID int) []*entity.Round {
+	var rounds []*entity.Round
+
+	for _, round := range game.Rounds {
+		if round.StageID != nil && *round.StageID == stageID {
+			rounds = append(rounds, round)
+		}
+	}
+
+	return rounds
+}
+
+// NewStageSetups returns the input of the table draw for every group of a stage, the first group
+// first. Players of a stage open to all players keep their teams; the qualifiers of later stages are
+// seated as teams of their own. With a table layout, every group gets the next tables of the layout it
+// needs; groups left without tables are seated at tables of the table size.
+func NewStageSetups(game entity.Game, stage entity.Stage) []setup.TeamSetup {
+	drawPlayers := map[int]setup.Player{}
+	for _, player := range game.AllPlayers() {
+		drawPlayers[player.ID] = DrawPlayer(player)
+	}
+
+	teamSize := 1
+	if stage.Qualification == entity.QualifyAll {
+		teamSize = game.TeamSize
+	}
+
+	setups := make([]setup.TeamSetup, stage.Groups)
+	for i := range setups {
+		setups[i] = setup.TeamSetup{
+			Teams:       map[int][]int{},
+			TeamSize:    teamSize,
+			TableSize:   game.TableSize,
+			ShortTables: game.ShortTables,
+			Byes:        game.Byes,
+		}
+	}
+
+	for _, stagePlayer := range stage.Players {
+		if stagePlayer.GroupNumber < 1 || stagePlayer.GroupNumber > stage.Groups {
+			continue
+		}
+
+		teamID := stagePlayer.PlayerID
+		if stage.Qualification == entity.QualifyAll {
+			teamID = drawPlayers[stagePlayer.PlayerID].TeamID
+		}
+
+		teams := setups[stagePlayer.GroupNumber-1].Teams
+		teams[teamID] = append(teams[teamID], stagePlayer.PlayerID)
+	}
+
+	tables := game.TableLayout
+	for i, groupSetup := range setups {
+		players := 0
+
+		for _, members := range groupSetup.Teams {
+			slices.Sort(members)
+
+			players += len(members)
+		}
+
+		if len(game.TableLayout) > 0 {
+			setups[i].TableLayout, tables = groupLayout(tables, players)
+		}
+	}
+
+	return setups
+}
+
+// groupLayout takes the tables of a group from the front of the remaining tables of the venue, as many
+// as it needs to seat the given number of players, and returns them together with the tables left over.
+func groupLayout(tables []int, players int) ([]int, []int) {
+	count, seats := 0, 0
+	for count < len(tables) && seats < players {
+		seats += tables[count]
+		count++
+	}
+
+	return slices.Clone(tables[:count]), tables[count:]
+}
+
+// NewStageSetup returns the players of all groups of a stage as a single setup, matching the tables
+// of the groups merged by setup.MergeTables. With a table layout, the layout of the merged setup lists
+// the seats of the tables every group is drawn on, the tables of the first group first.
+func NewStageSetup(game entity.Game, stage entity.Stage) setup.TeamSetup {
+	setups := NewStageSetups(game, stage)
+
+	merged := setup.TeamSetup{Teams: map[int][]int{}, TeamSize: 1, TableSize: game.TableSize, ShortTables: game.ShortTables, Byes: game.Byes}
+	if len(setups) > 0 {
+		merged.TeamSize = setups[0].TeamSize
+	}
+
+	for _, groupSetup := range setups {
+		for teamID, members := range groupSetup.Teams {
+			merged.Teams[teamID] = append(merged.Teams[teamID], members...)
+		}
+
+		if len(game.TableLayout) == 0 {
+			continue
+		}
+
+		layout, _ := setup.PlanTables(groupSetup)
+		for table := range layout.Tables() {
+			merged.TableLayout = append(merged.TableLayout, groupSetup.Seats(table))
+		}
+	}
+
+	return merged
+}
+
+// StartStage puts a stage in progress with the players set on it and draws its rounds, every group on
+// its own tables. Swiss stages draw their first round only. The rounds continue the round numbers of
+// the game, and the stage in progress so far is completed.
+func (s *GamesService) StartStage(ctx context.Context, game entity.Game, stage entity.Stage, seed int64) (entity.Stage, error) {
+	rounds, err := drawStage(game, stage, seed)
+	if err != nil {
+		return entity.Stage{}, err
+	}
+
+	teamSetup := NewStageSetup(game, stage)
+
+	firstRound := 1
+	for _, round := range game.Rounds {
+		firstRound = max(firstRound, round.RoundNumber+1)
+	}
+
+	err = s.repo.WithinTransaction(ctx, func(ctx context.Context, txRepo *GamesRepository) error {
+		if current := CurrentStage(game); current != nil {
+			if err := txRepo.UpdateStageStatus(ctx, current.ID, entity.StageStatusCompleted); err != nil {
+				return fmt.Errorf("cannot complete stage: %w", err)
+			}
+		}
+
+		if err := txRepo.UpdateStageStatus(ctx, stage.ID, entity.StageStatusInProgress); err != nil {
+			return fmt.Errorf("cannot start stage: %w", err)
+		}
+
+		stagePlayers := make([]entity.StagePlayer, len(stage.Players))
+		for i, stagePlayer := range stage.Players {
+			stagePlayers[i] = *stagePlayer
+			stagePlayers[i].StageID = stage.ID
+		}
+
+		if err := txRepo.CreateStagePlayers(ctx, stagePlayers); err != nil {
+			return fmt.Errorf("cannot create stage players: %w", err)
+		}
+
+		for i, tables := range rounds {
+			if _, err := createRound(ctx, txRepo, game.ID, firstRound+i, &stage.ID, teamSetup, tables); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.Stage{}, err
+	}
+
+	stage.Status = entity.StageStatusInProgress
+
+	return stage, nil
+}
+
+// CompleteStage completes a stage in progress.
+func (s *GamesService) CompleteStage(ctx context.Context, stage entity.Stage) (entity.Stage, error) {
+	if err := s.repo.UpdateStageStatus(ctx, stage.ID, entity.StageStatusCompleted); err != nil {
+		return entity.Stage{}, err
+	}
+
+	stage.Status = entity.StageStatusCompleted
+
+	return stage, nil
+}
+
+// ReplaceStages replaces the stages of a game by the given ones, numbered in the given order.
+func (s *GamesService) ReplaceStages(ctx context.Context, gameID int, stages []entity.Stage) ([]entity.Stage, error) {
+	for i := range stages {
+		stages[i].GameID = gameID
+		stages[i].Position = i + 1
+		stages[i].Status = entity.StageStatusPending
+	}
+
+	if err := s.repo.ReplaceStages(ctx, gameID, stages); err != nil {
+		return nil, err
+	}
+
+	return stages, nil
+}
+
+// drawStage draws the rounds a stage starts with for every group and merges the tables of the groups
+// round by round. The players of every table are ordered by seat, continuing the rotation of the
+// starting player over the earlier rounds of the game.
+func drawStage(game entity.Game, stage entity.Stage, seed int64) ([]setup.TeamsPlayersMapping, error) {
+	numberOfRounds := stage.NumberOfRounds
+	if stage.PairingMode == entity.PairingSwiss {
+		numberOfRounds = 1
+	}
+
+	rounds := make([]setup.TeamsPlayersMapping, numberOfRounds)
+	groupTables := make([][]setup.TeamsPlayersMapping, numberOfRounds)
+
+	for _, groupSetup := range NewStageSetups(game, stage) {
+		if _, ok := setup.PlanTables(groupSetup); !ok {
+			return nil, apperror.ErrInvalidGameSetup
+		}
+
+		groupRounds, _, err := setup.AssignRounds(groupSetup, numberOfRounds, seed)
+		if err != nil {
+			return nil, apperror.ErrTableAssignment
+		}
+
+		for i, tables := range groupRounds {
+			groupTables[i] = append(groupTables[i], tables)
+		}
+	}
+
+	starts := startCounts(game, 0)
+	for i := range rounds {
+		rounds[i] = setup.MergeTables(groupTables[i])
+		setup.AssignSeats(rounds[i], starts)
+	}
+
+	return rounds, nil
+}
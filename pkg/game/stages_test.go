@@ -0,0 +1,74 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+	"github.com/henok321/knobel-manager-service/pkg/setup"
+)
+
+func TestNewStageSetups(t *testing.T) {
+	first, second := 1, 2
+	game := entity.Game{
+		TeamSize:    2,
+		TableSize:   2,
+		ShortTables: true,
+		TableLayout: entity.TableLayout{3, 2},
+		Teams: []*entity.Team{
+			{ID: first, Players: []*entity.Player{{ID: 1, TeamID: &first}, {ID: 2, TeamID: &first}}},
+			{ID: second, Players: []*entity.Player{{ID: 3, TeamID: &second}, {ID: 4, TeamID: &second}}},
+		},
+	}
+
+	stage := entity.Stage{ID: 2, Groups: 2, Qualification: entity.QualifyTopOverall, Players: []*entity.StagePlayer{
+		{StageID: 2, PlayerID: 1, GroupNumber: 1},
+		{StageID: 2, PlayerID: 3, GroupNumber: 1},
+		{StageID: 2, PlayerID: 2, GroupNumber: 2},
+		{StageID: 2, PlayerID: 4, GroupNumber: 2},
+	}}
+
+	setups := NewStageSetups(game, stage)
+	require.Len(t, setups, 2)
+
+	assert.Equal(t, setup.TeamSetup{
+		Teams:       map[int][]int{1: {1}, 3: {3}},
+		TeamSize:    1,
+		TableSize:   2,
+		ShortTables: true,
+		TableLayout: []int{3},
+	}, setups[0])
+	assert.Equal(t, map[int][]int{2: {2}, 4: {4}}, setups[1].Teams)
+	assert.Equal(t, []int{2}, setups[1].TableLayout, "every group is seated at its own tables of the layout")
+
+	merged := NewStageSetup(game, stage)
+	assert.Equal(t, []int{3, 2}, merged.TableLayout, "the merged setup keeps the seats of every group")
+	assert.Equal(t, 3, merged.Seats(0))
+	assert.Equal(t, 2, merged.Seats(1))
+}
+
+func TestDrawStageWithTableLayout(t *testing.T) {
+	gameID := 1
+	game := entity.Game{ID: gameID, Mode: entity.GameModeIndividual, TeamSize: 1, TableSize: 4, TableLayout: entity.TableLayout{5, 4, 5, 4}}
+	stage := entity.Stage{ID: 1, Groups: 2, NumberOfRounds: 2, Qualification: entity.QualifyAll}
+
+	for id := 1; id <= 18; id++ {
+		game.Players = append(game.Players, &entity.Player{ID: id, GameID: &gameID})
+		stage.Players = append(stage.Players, &entity.StagePlayer{StageID: 1, PlayerID: id, GroupNumber: 1 + id%2})
+	}
+
+	rounds, err := drawStage(game, stage, 42)
+	require.NoError(t, err)
+
+	teamSetup := NewStageSetup(game, stage)
+
+	for _, tables := range rounds {
+		require.Len(t, tables, 4)
+
+		for tableNumber, players := range tables {
+			assert.Len(t, players, teamSetup.Seats(tableNumber), "the capacity of table %d matches its players", tableNumber+1)
+		}
+	}
+}
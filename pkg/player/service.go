@@ -9,16 +9,18 @@ import (
 	"github.com/henok321/knobel-manager-service/gen/api"
 	"github.com/henok321/knobel-manager-service/pkg/apperror"
 	"github.com/henok321/knobel-manager-service/pkg/entity"
+	"github.com/henok321/knobel-manager-service/pkg/game"
 	"github.com/henok321/knobel-manager-service/pkg/team"
 )
 
 type PlayersService struct {
-	playersRepo *PlayersRepository
-	teamsRepo   *team.TeamsRepository
+	playersRepo  *PlayersRepository
+	teamsRepo    *team.TeamsRepository
+	gamesService *game.GamesService
 }
 
-func NewPlayersService(playersRepo *PlayersRepository, teamsRepo *team.TeamsRepository) *PlayersService {
-	return &PlayersService{playersRepo: playersRepo, teamsRepo: teamsRepo}
+func NewPlayersService(playersRepo *PlayersRepository, teamsRepo *team.TeamsRepository, gamesService *game.GamesService) *PlayersService {
+	return &PlayersService{playersRepo: playersRepo, teamsRepo: teamsRepo, gamesService: gamesService}
 }
 
 func (s PlayersService) CreatePlayer(ctx context.Context, request api.PlayersRequest, teamID int, sub string) (entity.Player, error) {
@@ -36,7 +38,23 @@ func (s PlayersService) CreatePlayer(ctx context.Context, request api.PlayersReq
 		return entity.Player{}, apperror.ErrNotOwner
 	}
 
-	player := entity.Player{Name: request.Name, TeamID: teamID}
+	player := entity.Player{Name: request.Name, TeamID: &teamID}
+
+	return s.playersRepo.CreateOrUpdatePlayer(ctx, &player)
+}
+
+// CreateGamePlayer registers a player directly against an individual game.
+func (s PlayersService) CreateGamePlayer(ctx context.Context, request api.PlayersRequest, gameID int, sub string) (entity.Player, error) {
+	gameByID, err := s.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return entity.Player{}, err
+	}
+
+	if gameByID.Mode != entity.GameModeIndividual {
+		return entity.Player{}, apperror.ErrGameModeMismatch
+	}
+
+	player := entity.Player{Name: request.Name, GameID: &gameID}
 
 	return s.playersRepo.CreateOrUpdatePlayer(ctx, &player)
 }
@@ -51,13 +69,32 @@ func (s PlayersService) ownedPlayer(ctx context.Context, id int, sub string) (en
 		return entity.Player{}, err
 	}
 
-	if !entity.IsOwner(*player.Team.Game, sub) {
+	playerGame := player.Game
+	if player.Team != nil {
+		playerGame = player.Team.Game
+	}
+
+	if !entity.IsOwner(*playerGame, sub) {
 		return entity.Player{}, apperror.ErrNotOwner
 	}
 
 	return player, nil
 }
 
+// gamePlayer finds a player registered directly against the given individual game.
+func (s PlayersService) gamePlayer(ctx context.Context, gameID, id int, sub string) (entity.Player, error) {
+	player, err := s.ownedPlayer(ctx, id, sub)
+	if err != nil {
+		return entity.Player{}, err
+	}
+
+	if player.GameID == nil || *player.GameID != gameID {
+		return entity.Player{}, apperror.ErrPlayerNotFound
+	}
+
+	return player, nil
+}
+
 func (s PlayersService) UpdatePlayer(ctx context.Context, id int, request api.PlayersRequest, sub string) (entity.Player, error) {
 	player, err := s.ownedPlayer(ctx, id, sub)
 	if err != nil {
@@ -76,3 +113,22 @@ func (s PlayersService) DeletePlayer(ctx context.Context, id int, sub string) er
 
 	return s.playersRepo.DeletePlayer(ctx, id)
 }
+
+func (s PlayersService) UpdateGamePlayer(ctx context.Context, gameID, id int, request api.PlayersRequest, sub string) (entity.Player, error) {
+	player, err := s.gamePlayer(ctx, gameID, id, sub)
+	if err != nil {
+		return entity.Player{}, err
+	}
+
+	player.Name = request.Name
+
+	return s.playersRepo.CreateOrUpdatePlayer(ctx, &player)
+}
+
+func (s PlayersService) DeleteGamePlayer(ctx context.Context, gameID, id int, sub string) error {
+	if _, err := s.gamePlayer(ctx, gameID, id, sub); err != nil {
+		return err
+	}
+
+	return s.playersRepo.DeletePlayer(ctx, id)
+}
@@ -0,0 +1,174 @@
+package printout
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Size of an A4 page and the margin around its content in PDF points.
+const (
+	pageWidth  = 595.28
+	pageHeight = 841.89
+	margin     = 50.0
+)
+
+type font string
+
+const (
+	regular font = "F1"
+	bold    font = "F2"
+)
+
+// document is a minimal PDF writer for pages of text, lines and boxes. It uses the standard Helvetica
+// fonts every PDF viewer provides, so nothing has to be embedded. Text is encoded in WinAnsiEncoding,
+// which covers the Western European languages; other characters are printed as a question mark.
+type document struct {
+	pages []*page
+}
+
+type page struct {
+	content bytes.Buffer
+}
+
+func (d *document) newPage() *page {
+	p := &page{}
+	d.pages = append(d.pages, p)
+
+	return p
+}
+
+// text prints a line of text with its baseline starting at x, y.
+func (p *page) text(x, y float64, f font, size float64, value string) {
+	fmt.Fprintf(&p.content, "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", f, size, x, y, encode(value))
+}
+
+func (p *page) line(x1, y1, x2, y2 float64) {
+	fmt.Fprintf(&p.content, "%.2f %.2f m %.2f %.2f l S\n", x1, y1, x2, y2)
+}
+
+// box draws the outline of a rectangle with its lower left corner at x, y.
+func (p *page) box(x, y, width, height float64) {
+	fmt.Fprintf(&p.content, "%.2f %.2f %.2f %.2f re S\n", x, y, width, height)
+}
+
+// write writes the document with an empty page if it has none, as a PDF needs at least one page.
+func (d *document) write(w io.Writer) error {
+	if len(d.pages) == 0 {
+		d.newPage()
+	}
+
+	var out bytes.Buffer
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	var offsets []int
+
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// Objects 1 to 4 are the catalog, the page tree and both fonts; every page is followed by its content.
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, p := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>", pageWidth, pageHeight, 6+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", p.content.Len(), p.content.String()))
+	}
+
+	xref := out.Len()
+
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	_, err := w.Write(out.Bytes())
+
+	return err
+}
+
+// winAnsi maps the characters WinAnsiEncoding places between 0x80 and 0x9F. All other characters up to
+// 0xFF share their code with Latin-1.
+var winAnsi = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87, 'ˆ': 0x88, '‰': 0x89,
+	'Š': 0x8A, '‹': 0x8B, 'Œ': 0x8C, 'Ž': 0x8E, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95,
+	'–': 0x96, '—': 0x97, '˜': 0x98, '™': 0x99, 'š': 0x9A, '›': 0x9B, 'œ': 0x9C, 'ž': 0x9E, 'Ÿ': 0x9F,
+}
+
+// encode converts text to a WinAnsiEncoding PDF string literal, escaping the characters the literal
+// syntax reserves.
+func encode(value string) string {
+	var b strings.Builder
+
+	for _, r := range value {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20:
+			b.WriteByte(' ')
+		case r < 0x80 || (r >= 0xA0 && r <= 0xFF):
+			b.WriteByte(byte(r))
+		case winAnsi[r] != 0:
+			b.WriteByte(winAnsi[r])
+		default:
+			b.WriteByte('?')
+		}
+	}
+
+	return b.String()
+}
+
+// helveticaWidths are the widths of the printable ASCII characters of Helvetica in thousandths of the
+// font size, starting with the space.
+var helveticaWidths = [...]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+// textWidth estimates the width of text in Helvetica, counting characters outside ASCII as wide as a digit.
+func textWidth(value string, size float64) float64 {
+	width := 0
+
+	for _, r := range value {
+		if r >= ' ' && int(r-' ') < len(helveticaWidths) {
+			width += helveticaWidths[r-' ']
+		} else {
+			width += 556
+		}
+	}
+
+	return float64(width) * size / 1000
+}
+
+// fit shortens text with an ellipsis to fit into the given width.
+func fit(value string, size, width float64) string {
+	if textWidth(value, size) <= width {
+		return value
+	}
+
+	runes := []rune(value)
+	for len(runes) > 0 && textWidth(string(runes)+"…", size) > width {
+		runes = runes[:len(runes)-1]
+	}
+
+	return string(runes) + "…"
+}
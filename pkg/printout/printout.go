@@ -0,0 +1,187 @@
+package printout
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+const (
+	titleSize = 18.0
+	textSize  = 12.0
+)
+
+// WriteSeatingPlan writes the seating plan of a round as a PDF: every table with its players in seat
+// order and, in a team game, their team names, followed by the players sitting out the round. A table
+// never breaks across pages.
+func WriteSeatingPlan(w io.Writer, game entity.Game, round entity.Round) error {
+	doc := &document{}
+	teamNames := teamNamesOf(game)
+
+	var (
+		p *page
+		y float64
+	)
+
+	newPage := func() {
+		p = doc.newPage()
+		y = heading(p, game.Name, fmt.Sprintf("Seating plan – Round %d", round.RoundNumber))
+	}
+
+	block := func(title string, players []*entity.Player, numbered bool) {
+		height := 22 + 18*float64(len(players)) + 14
+		if p == nil || y-height < margin {
+			newPage()
+		}
+
+		p.text(margin, y, bold, 14, title)
+		y -= 22
+
+		for i, player := range players {
+			if numbered {
+				p.text(margin, y, regular, textSize, fmt.Sprintf("%d.", i+1))
+			}
+
+			p.text(margin+30, y, regular, textSize, fit(player.Name, textSize, 250))
+
+			if teamName := teamNames[player.ID]; teamName != "" {
+				p.text(margin+290, y, regular, textSize, fit(teamName, textSize, pageWidth-2*margin-290))
+			}
+
+			y -= 18
+		}
+
+		y -= 14
+	}
+
+	for _, table := range sortedTables(round) {
+		block(fmt.Sprintf("Table %d", table.TableNumber), table.SeatedPlayers(), true)
+	}
+
+	if byes := sittingOut(game, round); len(byes) > 0 {
+		block("Sitting out", byes, false)
+	}
+
+	if p == nil {
+		newPage()
+	}
+
+	return doc.write(w)
+}
+
+// WriteScoreSheets writes one score sheet page per table of a round as a PDF, listing the players of the
+// table in seat order with an empty box for every score and a line to sign for the table.
+func WriteScoreSheets(w io.Writer, game entity.Game, round entity.Round) error {
+	doc := &document{}
+	teamNames := teamNamesOf(game)
+	individual := game.Mode == entity.GameModeIndividual
+
+	const (
+		rowHeight   = 32.0
+		seatWidth   = 45.0
+		scoreWidth  = 100.0
+		playerWidth = 200.0
+	)
+
+	contentWidth := pageWidth - 2*margin
+	playerX := margin + seatWidth
+	teamX := playerX + playerWidth
+	scoreX := margin + contentWidth - scoreWidth
+
+	if individual {
+		teamX = scoreX
+	}
+
+	for _, table := range sortedTables(round) {
+		p := doc.newPage()
+		y := heading(p, game.Name, fmt.Sprintf("Score sheet – Round %d – Table %d", round.RoundNumber, table.TableNumber))
+
+		columns := []struct {
+			x     float64
+			title string
+		}{{margin, "Seat"}, {playerX, "Player"}, {teamX, "Team"}, {scoreX, "Score"}}
+		if individual {
+			columns = slices.Delete(columns, 2, 3)
+		}
+
+		for _, column := range columns {
+			p.text(column.x+6, y-rowHeight+12, bold, textSize, column.title)
+		}
+
+		p.box(margin, y-rowHeight, contentWidth, rowHeight)
+		y -= rowHeight
+
+		for i, player := range table.SeatedPlayers() {
+			p.box(margin, y-rowHeight, contentWidth, rowHeight)
+			p.box(scoreX, y-rowHeight, scoreWidth, rowHeight)
+
+			p.text(margin+6, y-rowHeight+12, regular, textSize, fmt.Sprintf("%d", i+1))
+			p.text(playerX+6, y-rowHeight+12, regular, textSize, fit(player.Name, textSize, teamX-playerX-12))
+
+			if !individual {
+				p.text(teamX+6, y-rowHeight+12, regular, textSize, fit(teamNames[player.ID], textSize, scoreX-teamX-12))
+			}
+
+			y -= rowHeight
+		}
+
+		y -= 3 * rowHeight
+		p.text(margin, y, regular, textSize, "Signature")
+		p.line(margin+70, y-2, margin+contentWidth/2, y-2)
+	}
+
+	return doc.write(w)
+}
+
+// heading prints the title and subtitle at the top of a page and returns where the content starts.
+func heading(p *page, title, subtitle string) float64 {
+	y := pageHeight - margin - titleSize
+	p.text(margin, y, bold, titleSize, fit(title, titleSize, pageWidth-2*margin))
+
+	y -= 22
+	p.text(margin, y, regular, 14, subtitle)
+
+	return y - 36
+}
+
+// teamNamesOf maps the players of a team game to their team names.
+func teamNamesOf(game entity.Game) map[int]string {
+	teamNames := map[int]string{}
+
+	for _, team := range game.Teams {
+		for _, player := range team.Players {
+			teamNames[player.ID] = team.Name
+		}
+	}
+
+	return teamNames
+}
+
+func sortedTables(round entity.Round) []*entity.GameTable {
+	tables := slices.Clone(round.Tables)
+	slices.SortFunc(tables, func(a, b *entity.GameTable) int {
+		return cmp.Compare(a.TableNumber, b.TableNumber)
+	})
+
+	return tables
+}
+
+// sittingOut returns the players sitting out a round ordered by ID.
+func sittingOut(game entity.Game, round entity.Round) []*entity.Player {
+	var players []*entity.Player
+
+	for _, player := range game.AllPlayers() {
+		if slices.ContainsFunc(round.Byes, func(bye *entity.RoundBye) bool { return bye.PlayerID == player.ID }) {
+			players = append(players, player)
+		}
+	}
+
+	slices.SortFunc(players, func(a, b *entity.Player) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return players
+}
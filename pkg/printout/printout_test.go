@@ -0,0 +1,118 @@
+package printout
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+func roundGame() (entity.Game, entity.Round) {
+	players := []*entity.Player{{ID: 1, Name: "Jürgen Müller"}, {ID: 2, Name: "Käthe (Kay) Schön"}, {ID: 3, Name: "Player 3"}, {ID: 4, Name: "Player 4"}, {ID: 5, Name: "Player 5"}}
+	game := entity.Game{
+		ID:   1,
+		Name: "Spring Cup",
+		Mode: entity.GameModeTeam,
+		Teams: []*entity.Team{
+			{ID: 1, Name: "Team Müller", Players: []*entity.Player{players[0], players[2]}},
+			{ID: 2, Name: "Team Schön", Players: []*entity.Player{players[1], players[3], players[4]}},
+		},
+	}
+	round := entity.Round{
+		RoundNumber: 2,
+		Tables: []*entity.GameTable{
+			{TableNumber: 2, Players: []*entity.Player{players[2], players[3]}},
+			{TableNumber: 1, Players: []*entity.Player{players[0], players[1]}, Seats: []*entity.TablePlayer{{PlayerID: 2, Seat: 1}, {PlayerID: 1, Seat: 2}}},
+		},
+		Byes: []*entity.RoundBye{{PlayerID: 5}},
+	}
+
+	return game, round
+}
+
+// checkPDF verifies that every cross-reference entry points at its object and returns the number of pages.
+func checkPDF(t *testing.T, pdf []byte) int {
+	t.Helper()
+
+	require.True(t, bytes.HasPrefix(pdf, []byte("%PDF-1.4\n")))
+	require.True(t, bytes.HasSuffix(pdf, []byte("%%EOF\n")))
+
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(pdf)
+	require.NotNil(t, startxref)
+
+	xref, err := strconv.Atoi(string(startxref[1]))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(pdf[xref:], []byte("xref\n")))
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(pdf[xref:], -1)
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(string(entry[1]))
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(pdf[offset:], fmt.Appendf(nil, "%d 0 obj\n", i+1)), "object %d", i+1)
+	}
+
+	pages := regexp.MustCompile(`/Type /Pages /Kids \[[^\]]*\] /Count (\d+)`).FindSubmatch(pdf)
+	require.NotNil(t, pages)
+
+	count, err := strconv.Atoi(string(pages[1]))
+	require.NoError(t, err)
+
+	return count
+}
+
+func TestWriteSeatingPlan(t *testing.T) {
+	game, round := roundGame()
+
+	var out bytes.Buffer
+	require.NoError(t, WriteSeatingPlan(&out, game, round))
+
+	pdf := out.String()
+
+	assert.Equal(t, 1, checkPDF(t, out.Bytes()))
+	assert.Contains(t, pdf, "(Seating plan \x96 Round 2)")
+	assert.Less(t, strings.Index(pdf, "(Table 1)"), strings.Index(pdf, "(Table 2)"))
+	assert.Less(t, strings.Index(pdf, "(K\xe4the \\(Kay\\) Sch\xf6n)"), strings.Index(pdf, "(J\xfcrgen M\xfcller)"), "players are listed in seat order")
+	assert.Contains(t, pdf, "(Team M\xfcller)")
+	assert.Contains(t, pdf, "(Sitting out)")
+	assert.Contains(t, pdf, "(Player 5)")
+}
+
+func TestWriteScoreSheets(t *testing.T) {
+	game, round := roundGame()
+
+	var out bytes.Buffer
+	require.NoError(t, WriteScoreSheets(&out, game, round))
+
+	pdf := out.String()
+
+	assert.Equal(t, 2, checkPDF(t, out.Bytes()), "one page per table")
+	assert.Less(t, strings.Index(pdf, "(Score sheet \x96 Round 2 \x96 Table 1)"), strings.Index(pdf, "(Score sheet \x96 Round 2 \x96 Table 2)"))
+	assert.Contains(t, pdf, "(Team Sch\xf6n)")
+	assert.NotContains(t, pdf, "(Player 5)", "players sitting out get no score sheet")
+}
+
+func TestWriteScoreSheetsWithoutTables(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, WriteScoreSheets(&out, entity.Game{Name: "Game"}, entity.Round{RoundNumber: 1}))
+
+	assert.Equal(t, 1, checkPDF(t, out.Bytes()), "a PDF has at least one page")
+}
+
+func TestEncode(t *testing.T) {
+	assert.Equal(t, "M\xfcller \\(\\\\\\) \x80 \x96 ?", encode("Müller (\\) € – 漢"))
+}
+
+func TestFit(t *testing.T) {
+	assert.Equal(t, "Player 1", fit("Player 1", 12, 100))
+
+	shortened := fit("A very long player name that does not fit", 12, 100)
+	assert.True(t, strings.HasSuffix(shortened, "…"))
+	assert.LessOrEqual(t, textWidth(shortened, 12), 100.0)
+}
@@ -0,0 +1,33 @@
+package printout
+
+import (
+	"context"
+
+	"github.com/henok321/knobel-manager-service/pkg/apperror"
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+	"github.com/henok321/knobel-manager-service/pkg/game"
+)
+
+type PrintoutsService struct {
+	gamesService *game.GamesService
+}
+
+func NewPrintoutsService(gamesService *game.GamesService) *PrintoutsService {
+	return &PrintoutsService{gamesService: gamesService}
+}
+
+// Round returns a game together with the round to print.
+func (s *PrintoutsService) Round(ctx context.Context, gameID, roundNumber int, sub string) (entity.Game, entity.Round, error) {
+	gameByID, err := s.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return entity.Game{}, entity.Round{}, err
+	}
+
+	for _, round := range gameByID.Rounds {
+		if round.RoundNumber == roundNumber {
+			return gameByID, *round, nil
+		}
+	}
+
+	return entity.Game{}, entity.Round{}, apperror.ErrRoundNotFound
+}
@@ -0,0 +1,177 @@
+package results
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+	"github.com/henok321/knobel-manager-service/pkg/standings"
+)
+
+// Build renders the results of a game. The standings sheet lists every player with the score of every
+// drawn round, completed or not, the total and the rank, ranked by the final if the game has one,
+// followed by the teams with their round and total scores. Every round sheet lists the score of every player at every table in seat order
+// and the players sitting out the round with the bye score. Missing scores are left empty.
+func Build(game entity.Game) Workbook {
+	rounds := slices.Clone(game.Rounds)
+	slices.SortFunc(rounds, func(a, b *entity.Round) int {
+		return cmp.Compare(a.RoundNumber, b.RoundNumber)
+	})
+
+	teamNames := make(map[int]string, len(game.Teams))
+	for _, team := range game.Teams {
+		teamNames[team.ID] = team.Name
+	}
+
+	workbook := Workbook{GameID: game.ID, Sheets: []Sheet{standingsSheet(game, rounds, teamNames)}}
+
+	for _, round := range rounds {
+		workbook.Sheets = append(workbook.Sheets, roundSheet(game, *round, teamNames))
+	}
+
+	return workbook
+}
+
+func standingsSheet(game entity.Game, rounds []*entity.Round, teamNames map[int]string) Sheet {
+	individual := game.Mode == entity.GameModeIndividual
+
+	lastRound := 0
+	if len(rounds) > 0 {
+		lastRound = rounds[len(rounds)-1].RoundNumber
+	}
+
+	// The headers list every drawn round, so the standings are calculated after the last one rather
+	// than after the last completed round.
+	current := standings.Calculate(game, lastRound)
+
+	header := []Cell{text("Rank"), text("Player")}
+	if !individual {
+		header = append(header, text("Team"))
+	}
+
+	header = append(append(header, roundHeaders(rounds)...), text("Total"))
+
+	if game.Final != nil {
+		header = append(header, text("Preliminary rank"), text("Final score"))
+	}
+
+	rows := [][]Cell{header}
+
+	if game.Final == nil {
+		for _, player := range current.Players {
+			rows = append(rows, playerRow(player.Rank, player, teamNames, individual))
+		}
+	} else {
+		players := make(map[int]standings.PlayerStanding, len(current.Players))
+		for _, player := range current.Players {
+			players[player.PlayerID] = player
+		}
+
+		for _, player := range standings.RankFinal(game).Players {
+			row := playerRow(player.Rank, players[player.PlayerID], teamNames, individual)
+			rows = append(rows, append(row, number(player.PreliminaryRank), optionalNumber(player.FinalScore)))
+		}
+	}
+
+	if !individual {
+		rows = append(rows, nil, append(append([]Cell{text("Rank"), text("Team")}, roundHeaders(rounds)...), text("Total")))
+
+		for _, team := range current.Teams {
+			row := []Cell{number(team.Rank), text(team.Name)}
+			for _, subtotal := range team.Rounds {
+				row = append(row, number(subtotal.Score))
+			}
+
+			rows = append(rows, append(row, number(team.TotalScore)))
+		}
+	}
+
+	return Sheet{Name: "Standings", Rows: rows}
+}
+
+func roundHeaders(rounds []*entity.Round) []Cell {
+	headers := make([]Cell, len(rounds))
+	for i, round := range rounds {
+		headers[i] = text(fmt.Sprintf("Round %d", round.RoundNumber))
+	}
+
+	return headers
+}
+
+func playerRow(rank int, player standings.PlayerStanding, teamNames map[int]string, individual bool) []Cell {
+	row := []Cell{number(rank), text(player.Name)}
+	if !individual {
+		row = append(row, text(teamNames[player.TeamID]))
+	}
+
+	for _, subtotal := range player.Rounds {
+		row = append(row, number(subtotal.Score))
+	}
+
+	return append(row, number(player.TotalScore))
+}
+
+func roundSheet(game entity.Game, round entity.Round, teamNames map[int]string) Sheet {
+	individual := game.Mode == entity.GameModeIndividual
+
+	header := []Cell{text("Table"), text("Seat"), text("Player")}
+	if !individual {
+		header = append(header, text("Team"))
+	}
+
+	rows := [][]Cell{append(header, text("Score"))}
+
+	seatRow := func(table, seat Cell, player *entity.Player, score Cell) []Cell {
+		row := []Cell{table, seat, text(player.Name)}
+		if !individual {
+			teamName := ""
+			if player.TeamID != nil {
+				teamName = teamNames[*player.TeamID]
+			}
+
+			row = append(row, text(teamName))
+		}
+
+		return append(row, score)
+	}
+
+	tables := slices.Clone(round.Tables)
+	slices.SortFunc(tables, func(a, b *entity.GameTable) int {
+		return cmp.Compare(a.TableNumber, b.TableNumber)
+	})
+
+	for _, table := range tables {
+		scores := make(map[int]int, len(table.Scores))
+		for _, score := range table.Scores {
+			scores[score.PlayerID] = score.Score
+		}
+
+		for i, player := range table.SeatedPlayers() {
+			score := Cell{}
+			if value, ok := scores[player.ID]; ok {
+				score = number(value)
+			}
+
+			rows = append(rows, seatRow(number(table.TableNumber), number(i+1), player, score))
+		}
+	}
+
+	players := make(map[int]*entity.Player)
+	for _, player := range game.AllPlayers() {
+		players[player.ID] = player
+	}
+
+	byes := slices.Clone(round.Byes)
+	slices.SortFunc(byes, func(a, b *entity.RoundBye) int {
+		return cmp.Compare(a.PlayerID, b.PlayerID)
+	})
+
+	for _, bye := range byes {
+		if player, ok := players[bye.PlayerID]; ok {
+			rows = append(rows, seatRow(text("Bye"), Cell{}, player, number(game.ByeScore)))
+		}
+	}
+
+	return Sheet{Name: fmt.Sprintf("Round %d", round.RoundNumber), Rows: rows}
+}
@@ -0,0 +1,57 @@
+package results
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+// utf8BOM makes spreadsheet applications such as Excel read the file as UTF-8.
+const utf8BOM = "\xef\xbb\xbf"
+
+// WriteCSV writes the sheets of a workbook one after another, each headed by its name and separated from
+// the previous one by an empty line.
+func WriteCSV(w io.Writer, workbook Workbook) error {
+	if _, err := io.WriteString(w, utf8BOM); err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+
+	for i, sheet := range workbook.Sheets {
+		if i > 0 {
+			if err := writer.Write(nil); err != nil {
+				return err
+			}
+		}
+
+		if err := writer.Write([]string{sheet.Name}); err != nil {
+			return err
+		}
+
+		for _, row := range sheet.Rows {
+			record := make([]string, len(row))
+			for j, cell := range row {
+				record[j] = csvField(cell)
+			}
+
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// csvField quotes text a spreadsheet application would otherwise evaluate as a formula.
+func csvField(cell Cell) string {
+	value := cell.String()
+	if !cell.Numeric && value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
@@ -0,0 +1,47 @@
+package results
+
+import "strconv"
+
+// Cell is a single cell of a sheet holding either text or a number. The zero value is an empty cell.
+type Cell struct {
+	Text    string
+	Number  int
+	Numeric bool
+}
+
+func (c Cell) String() string {
+	if c.Numeric {
+		return strconv.Itoa(c.Number)
+	}
+
+	return c.Text
+}
+
+// Sheet is a named table of rows. Rows may differ in length, and an empty row separates two sections.
+type Sheet struct {
+	Name string
+	Rows [][]Cell
+}
+
+// Workbook holds the results of a game: the standings followed by one sheet per round.
+type Workbook struct {
+	GameID int
+	Sheets []Sheet
+}
+
+func text(value string) Cell {
+	return Cell{Text: value}
+}
+
+func number(value int) Cell {
+	return Cell{Number: value, Numeric: true}
+}
+
+// optionalNumber returns an empty cell for a missing value.
+func optionalNumber(value *int) Cell {
+	if value == nil {
+		return Cell{}
+	}
+
+	return number(*value)
+}
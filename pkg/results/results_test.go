@@ -0,0 +1,185 @@
+package results
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+func teamGame() entity.Game {
+	teamID := func(id int) *int { return &id }
+	players := []*entity.Player{
+		{ID: 1, Name: "Player 1", TeamID: teamID(1)},
+		{ID: 2, Name: "=Player 2", TeamID: teamID(1)},
+		{ID: 3, Name: "Player 3", TeamID: teamID(2)},
+		{ID: 4, Name: "Player 4", TeamID: teamID(2)},
+		{ID: 5, Name: "Player 5", TeamID: teamID(2)},
+	}
+
+	return entity.Game{
+		ID:             1,
+		Mode:           entity.GameModeTeam,
+		ScoreDirection: entity.HigherWins,
+		ByeScore:       1,
+		Teams: []*entity.Team{
+			{ID: 1, Name: "Team 1", Players: players[:2]},
+			{ID: 2, Name: "Team 2", Players: players[2:]},
+		},
+		Rounds: []*entity.Round{{
+			RoundNumber: 1,
+			Status:      entity.RoundStatusCompleted,
+			Tables: []*entity.GameTable{
+				{
+					TableNumber: 2,
+					Players:     []*entity.Player{players[1], players[3]},
+					Scores:      []*entity.Score{{PlayerID: 2, Score: 2}},
+				},
+				{
+					TableNumber: 1,
+					Players:     []*entity.Player{players[0], players[2]},
+					Seats:       []*entity.TablePlayer{{PlayerID: 3, Seat: 1}, {PlayerID: 1, Seat: 2}},
+					Scores:      []*entity.Score{{PlayerID: 1, Score: 5}, {PlayerID: 3, Score: 3}},
+				},
+			},
+			Byes: []*entity.RoundBye{{PlayerID: 5}},
+		}},
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, WriteCSV(&out, Build(teamGame())))
+
+	assert.Equal(t, utf8BOM+`Standings
+Rank,Player,Team,Round 1,Total
+1,Player 1,Team 1,5,5
+2,Player 3,Team 2,3,3
+3,'=Player 2,Team 1,2,2
+4,Player 5,Team 2,1,1
+5,Player 4,Team 2,0,0
+
+Rank,Team,Round 1,Total
+1,Team 1,7,7
+2,Team 2,4,4
+
+Round 1
+Table,Seat,Player,Team,Score
+1,1,Player 3,Team 2,3
+1,2,Player 1,Team 1,5
+2,1,'=Player 2,Team 1,2
+2,2,Player 4,Team 2,
+Bye,,Player 5,Team 2,1
+`, out.String())
+}
+
+func TestBuildWithRoundsInProgress(t *testing.T) {
+	game := teamGame()
+	players := game.AllPlayers()
+	game.Rounds = append(game.Rounds,
+		&entity.Round{RoundNumber: 2, Status: entity.RoundStatusInProgress, Tables: []*entity.GameTable{{
+			TableNumber: 1,
+			Players:     []*entity.Player{players[0], players[2]},
+			Scores:      []*entity.Score{{PlayerID: 1, Score: 4}},
+		}}},
+		&entity.Round{RoundNumber: 3, Status: entity.RoundStatusSetup, Tables: []*entity.GameTable{{
+			TableNumber: 1,
+			Players:     []*entity.Player{players[1], players[3]},
+		}}},
+	)
+
+	rows := Build(game).Sheets[0].Rows
+
+	assert.Equal(t, []Cell{text("Rank"), text("Player"), text("Team"), text("Round 1"), text("Round 2"), text("Round 3"), text("Total")}, rows[0])
+	assert.Equal(t, []Cell{number(1), text("Player 1"), text("Team 1"), number(5), number(4), number(0), number(9)}, rows[1], "every drawn round has its own column before the total")
+
+	header := rows[0]
+	for _, row := range rows[1:] {
+		if row == nil {
+			header = nil
+			continue
+		}
+
+		if header == nil {
+			header = row
+		}
+
+		assert.Len(t, row, len(header), "every row matches its header")
+	}
+}
+
+func TestBuildRanksByFinal(t *testing.T) {
+	score := func(value int) *int { return &value }
+	game := entity.Game{
+		ID:             1,
+		Mode:           entity.GameModeIndividual,
+		ScoreDirection: entity.HigherWins,
+		Players:        []*entity.Player{{ID: 1, Name: "Player 1"}, {ID: 2, Name: "Player 2"}, {ID: 3, Name: "Player 3"}},
+		Rounds: []*entity.Round{{
+			RoundNumber: 1,
+			Status:      entity.RoundStatusCompleted,
+			Tables: []*entity.GameTable{{
+				TableNumber: 1,
+				Players:     []*entity.Player{{ID: 1, Name: "Player 1"}, {ID: 2, Name: "Player 2"}, {ID: 3, Name: "Player 3"}},
+				Scores:      []*entity.Score{{PlayerID: 1, Score: 6}, {PlayerID: 2, Score: 4}, {PlayerID: 3, Score: 2}},
+			}},
+		}},
+		Final: &entity.Final{Finalists: 2, Tables: []*entity.FinalTable{{
+			TableNumber: 1,
+			Players:     []*entity.FinalPlayer{{PlayerID: 1, Seed: 1, Score: score(1)}, {PlayerID: 2, Seed: 2, Score: score(3)}},
+		}}},
+	}
+
+	assert.Equal(t, [][]Cell{
+		{text("Rank"), text("Player"), text("Round 1"), text("Total"), text("Preliminary rank"), text("Final score")},
+		{number(1), text("Player 2"), number(4), number(4), number(2), number(3)},
+		{number(2), text("Player 1"), number(6), number(6), number(1), number(1)},
+		{number(3), text("Player 3"), number(2), number(2), number(3), {}},
+	}, Build(game).Sheets[0].Rows)
+}
+
+func TestWriteXLSX(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, WriteXLSX(&out, Build(teamGame())))
+
+	reader, err := zip.NewReader(bytes.NewReader(out.Bytes()), int64(out.Len()))
+	require.NoError(t, err)
+
+	parts := map[string]string{}
+
+	for _, file := range reader.File {
+		content, err := file.Open()
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(content)
+		require.NoError(t, err)
+
+		parts[file.Name] = string(data)
+	}
+
+	assert.ElementsMatch(t, []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/worksheets/sheet1.xml", "xl/worksheets/sheet2.xml"}, keys(parts))
+	assert.Contains(t, parts["xl/workbook.xml"], `<sheet name="Standings" sheetId="1" r:id="rId1"/><sheet name="Round 1" sheetId="2" r:id="rId2"/>`)
+	assert.Contains(t, parts["xl/worksheets/sheet1.xml"], `<row r="4"><c r="A4"><v>3</v></c><c r="B4" t="inlineStr"><is><t xml:space="preserve">=Player 2</t></is></c>`)
+	assert.Contains(t, parts["xl/worksheets/sheet1.xml"], `<row r="8"><c r="A8" t="inlineStr"><is><t xml:space="preserve">Rank</t></is></c>`, "the empty row 7 separates the teams")
+	assert.Contains(t, parts["xl/worksheets/sheet2.xml"], `<row r="5"><c r="A5"><v>2</v></c><c r="B5"><v>2</v></c><c r="C5" t="inlineStr"><is><t xml:space="preserve">Player 4</t></is></c><c r="D5" t="inlineStr"><is><t xml:space="preserve">Team 2</t></is></c></row>`)
+}
+
+func TestColumnName(t *testing.T) {
+	for index, expected := range map[int]string{0: "A", 25: "Z", 26: "AA", 51: "AZ", 52: "BA", 701: "ZZ", 702: "AAA"} {
+		assert.Equal(t, expected, columnName(index))
+	}
+}
+
+func keys(parts map[string]string) []string {
+	names := make([]string, 0, len(parts))
+	for name := range parts {
+		names = append(names, name)
+	}
+
+	return names
+}
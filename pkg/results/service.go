@@ -0,0 +1,25 @@
+package results
+
+import (
+	"context"
+
+	"github.com/henok321/knobel-manager-service/pkg/game"
+)
+
+type ResultsService struct {
+	gamesService *game.GamesService
+}
+
+func NewResultsService(gamesService *game.GamesService) *ResultsService {
+	return &ResultsService{gamesService: gamesService}
+}
+
+// Results returns the results of a game as a workbook.
+func (s *ResultsService) Results(ctx context.Context, gameID int, sub string) (Workbook, error) {
+	gameByID, err := s.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return Workbook{}, err
+	}
+
+	return Build(gameByID), nil
+}
@@ -0,0 +1,117 @@
+package results
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	xmlHeader     = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+	spreadsheetNS = "http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+	relationNS    = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+	packageNS     = "http://schemas.openxmlformats.org/package/2006/relationships"
+)
+
+// WriteXLSX writes a workbook as an Office Open XML spreadsheet with one worksheet per sheet. It writes
+// the minimal set of parts spreadsheet applications require, with text stored as inline strings.
+func WriteXLSX(w io.Writer, workbook Workbook) error {
+	var contentTypes, workbookXML, workbookRels strings.Builder
+
+	contentTypes.WriteString(xmlHeader + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	workbookXML.WriteString(xmlHeader + `<workbook xmlns="` + spreadsheetNS + `" xmlns:r="` + relationNS + `"><sheets>`)
+	workbookRels.WriteString(xmlHeader + `<Relationships xmlns="` + packageNS + `">`)
+
+	parts := make([][2]string, 0, len(workbook.Sheets)+4)
+
+	for i, sheet := range workbook.Sheets {
+		n := i + 1
+
+		fmt.Fprintf(&contentTypes, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, n)
+		fmt.Fprintf(&workbookXML, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, escape(sheet.Name), n, n)
+		fmt.Fprintf(&workbookRels, `<Relationship Id="rId%d" Type="%s/worksheet" Target="worksheets/sheet%d.xml"/>`, n, relationNS, n)
+
+		parts = append(parts, [2]string{fmt.Sprintf("xl/worksheets/sheet%d.xml", n), worksheet(sheet)})
+	}
+
+	contentTypes.WriteString(`</Types>`)
+	workbookXML.WriteString(`</sheets></workbook>`)
+	workbookRels.WriteString(`</Relationships>`)
+
+	parts = append(parts,
+		[2]string{"[Content_Types].xml", contentTypes.String()},
+		[2]string{"_rels/.rels", xmlHeader + `<Relationships xmlns="` + packageNS + `"><Relationship Id="rId1" Type="` + relationNS + `/officeDocument" Target="xl/workbook.xml"/></Relationships>`},
+		[2]string{"xl/workbook.xml", workbookXML.String()},
+		[2]string{"xl/_rels/workbook.xml.rels", workbookRels.String()},
+	)
+
+	archive := zip.NewWriter(w)
+
+	for _, part := range parts {
+		file, err := archive.Create(part[0])
+		if err != nil {
+			return err
+		}
+
+		if _, err := io.WriteString(file, part[1]); err != nil {
+			return err
+		}
+	}
+
+	return archive.Close()
+}
+
+// worksheet renders the rows of a sheet, leaving out empty rows and cells.
+func worksheet(sheet Sheet) string {
+	var b strings.Builder
+
+	b.WriteString(xmlHeader + `<worksheet xmlns="` + spreadsheetNS + `"><sheetData>`)
+
+	for i, row := range sheet.Rows {
+		if len(row) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&b, `<row r="%d">`, i+1)
+
+		for j, cell := range row {
+			ref := fmt.Sprintf("%s%d", columnName(j), i+1)
+
+			switch {
+			case cell.Numeric:
+				fmt.Fprintf(&b, `<c r="%s"><v>%d</v></c>`, ref, cell.Number)
+			case cell.Text != "":
+				fmt.Fprintf(&b, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, escape(cell.Text))
+			}
+		}
+
+		b.WriteString(`</row>`)
+	}
+
+	b.WriteString(`</sheetData></worksheet>`)
+
+	return b.String()
+}
+
+// columnName returns the letters of the zero based column index, A to Z followed by AA, AB and so on.
+func columnName(index int) string {
+	name := ""
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = string(rune('A'+(index-1)%26)) + name
+	}
+
+	return name
+}
+
+func escape(value string) string {
+	var b strings.Builder
+
+	_ = xml.EscapeText(&b, []byte(value))
+
+	return b.String()
+}
@@ -0,0 +1,88 @@
+package round
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+type RoundsRepository struct {
+	db *gorm.DB
+}
+
+func NewRoundsRepository(db *gorm.DB) *RoundsRepository {
+	return &RoundsRepository{db}
+}
+
+func (r *RoundsRepository) UpdateStatus(ctx context.Context, roundID int, status entity.RoundStatus) error {
+	return r.db.WithContext(ctx).Model(&entity.Round{ID: roundID}).Update("status", status).Error
+}
+
+// uniqueViolation reports whether an error is caused by a violated unique constraint.
+func uniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+func (r *RoundsRepository) CreatePlayer(ctx context.Context, player *entity.Player) error {
+	return r.db.WithContext(ctx).Create(player).Error
+}
+
+func (r *RoundsRepository) ReplaceTablePlayer(ctx context.Context, tableID, playerID, substituteID int) error {
+	return r.db.WithContext(ctx).Model(&entity.TablePlayer{}).
+		Where("game_table_id = ? AND player_id = ?", tableID, playerID).
+		Update("player_id", substituteID).Error
+}
+
+func (r *RoundsRepository) MoveTablePlayer(ctx context.Context, fromTableID, toTableID, playerID, seat int) error {
+	return r.db.WithContext(ctx).Model(&entity.TablePlayer{}).
+		Where("game_table_id = ? AND player_id = ?", fromTableID, playerID).
+		Updates(map[string]any{"game_table_id": toTableID, "seat": seat}).Error
+}
+
+func (r *RoundsRepository) SeatPlayer(ctx context.Context, tableID, playerID, seat int) error {
+	return r.db.WithContext(ctx).Create(&entity.TablePlayer{TableID: tableID, PlayerID: playerID, Seat: seat}).Error
+}
+
+func (r *RoundsRepository) ReplaceBye(ctx context.Context, roundID, playerID, replacementID int) error {
+	return r.db.WithContext(ctx).Model(&entity.RoundBye{}).
+		Where("round_id = ? AND player_id = ?", roundID, playerID).
+		Update("player_id", replacementID).Error
+}
+
+func (r *RoundsRepository) DeleteBye(ctx context.Context, roundID, playerID int) error {
+	return r.db.WithContext(ctx).Where("round_id = ? AND player_id = ?", roundID, playerID).Delete(&entity.RoundBye{}).Error
+}
+
+func (r *RoundsRepository) CreateSubstitutions(ctx context.Context, substitutions []entity.Substitution) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Create(substitutions).Error
+}
+
+func (r *RoundsRepository) FindSubstitutions(ctx context.Context, gameID int) ([]entity.Substitution, error) {
+	var substitutions []entity.Substitution
+
+	err := r.db.WithContext(ctx).
+		Joins("JOIN rounds ON rounds.id = substitutions.round_id").Where("rounds.game_id = ?", gameID).
+		Preload("Round").
+		Preload("Table").
+		Order("substitutions.id").
+		Find(&substitutions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return substitutions, nil
+}
+
+func (r *RoundsRepository) WithinTransaction(ctx context.Context, operation func(ctx context.Context, txRepo *RoundsRepository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &RoundsRepository{db: tx}
+		return operation(ctx, txRepo)
+	})
+}
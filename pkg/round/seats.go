@@ -0,0 +1,283 @@
+package round
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/henok321/knobel-manager-service/gen/api"
+	"github.com/henok321/knobel-manager-service/pkg/apperror"
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+// SwapSeats exchanges two players of a round. At least one of them must be seated; the other one may
+// be sitting out the round and then takes the seat while the seated player sits out instead. The
+// affected tables must not have scores and must not end up seating teammates together.
+func (s *RoundsService) SwapSeats(ctx context.Context, gameID, roundNumber int, sub string, request api.SeatSwapRequest) ([]entity.GameTable, error) {
+	gameByID, round, err := s.seatChangeRound(ctx, gameID, roundNumber, sub)
+	if err != nil {
+		return nil, err
+	}
+
+	player, other := playerOf(gameByID, request.PlayerID), playerOf(gameByID, request.OtherPlayerID)
+	if player == nil || other == nil {
+		return nil, apperror.ErrPlayerNotFound
+	}
+
+	playerTable, otherTable := seatedAt(round, player.ID), seatedAt(round, other.ID)
+
+	switch {
+	case playerTable == nil && !sitsOut(round, player.ID), otherTable == nil && !sitsOut(round, other.ID):
+		return nil, apperror.ErrPlayerNotSeated
+	case playerTable == nil && otherTable == nil:
+		return nil, apperror.ErrPlayerNotSeated
+	case playerTable == otherTable:
+		return nil, apperror.ErrSameTable
+	}
+
+	var changed []entity.GameTable
+
+	for _, swap := range []struct {
+		table          *entity.GameTable
+		leaves, enters *entity.Player
+	}{{playerTable, player, other}, {otherTable, other, player}} {
+		if swap.table == nil {
+			continue
+		}
+
+		players := replacePlayer(swap.table.Players, swap.leaves.ID, swap.enters)
+		if err := validateSeats(*swap.table, players); err != nil {
+			return nil, err
+		}
+
+		changed = append(changed, withPlayers(*swap.table, players, replaceSeat(swap.table.Seats, swap.leaves.ID, swap.enters.ID)))
+	}
+
+	err = s.repo.WithinTransaction(ctx, func(ctx context.Context, txRepo *RoundsRepository) error {
+		switch {
+		case playerTable == nil:
+			return swapWithBye(ctx, txRepo, round.ID, otherTable.ID, other.ID, player.ID)
+		case otherTable == nil:
+			return swapWithBye(ctx, txRepo, round.ID, playerTable.ID, player.ID, other.ID)
+		}
+
+		if err := txRepo.ReplaceTablePlayer(ctx, playerTable.ID, player.ID, other.ID); err != nil {
+			return err
+		}
+
+		return txRepo.ReplaceTablePlayer(ctx, otherTable.ID, other.ID, player.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sortedTables(changed), nil
+}
+
+// MoveSeat moves a player of a round to a free seat at another table, behind the players seated
+// there; a player sitting out the round takes the seat instead of the bye. The affected tables must
+// not have scores or seat teammates together, and the table left behind keeps at least two players.
+func (s *RoundsService) MoveSeat(ctx context.Context, gameID, roundNumber int, sub string, request api.SeatMoveRequest) ([]entity.GameTable, error) {
+	gameByID, round, err := s.seatChangeRound(ctx, gameID, roundNumber, sub)
+	if err != nil {
+		return nil, err
+	}
+
+	player := playerOf(gameByID, request.PlayerID)
+	if player == nil {
+		return nil, apperror.ErrPlayerNotFound
+	}
+
+	target := tableAt(round, request.TableNumber)
+	if target == nil {
+		return nil, apperror.ErrRoundOrTableNotFound
+	}
+
+	source := seatedAt(round, player.ID)
+
+	switch {
+	case source == nil && !sitsOut(round, player.ID):
+		return nil, apperror.ErrPlayerNotSeated
+	case source == target:
+		return nil, apperror.ErrSameTable
+	}
+
+	targetPlayers := append(slices.Clone(target.Players), player)
+	if err := validateSeats(*target, targetPlayers); err != nil {
+		return nil, err
+	}
+
+	seat := nextSeat(*target)
+	targetSeats := append(slices.Clone(target.Seats), &entity.TablePlayer{TableID: target.ID, PlayerID: player.ID, Seat: seat})
+
+	changed := []entity.GameTable{withPlayers(*target, targetPlayers, targetSeats)}
+
+	if source != nil {
+		sourcePlayers := slices.DeleteFunc(slices.Clone(source.Players), func(seated *entity.Player) bool {
+			return seated.ID == player.ID
+		})
+
+		if len(sourcePlayers) < 2 {
+			return nil, apperror.ErrTableTooSmall
+		}
+
+		if err := validateSeats(*source, sourcePlayers); err != nil {
+			return nil, err
+		}
+
+		sourceSeats := slices.DeleteFunc(slices.Clone(source.Seats), func(seated *entity.TablePlayer) bool {
+			return seated.PlayerID == player.ID
+		})
+
+		changed = append(changed, withPlayers(*source, sourcePlayers, sourceSeats))
+	}
+
+	err = s.repo.WithinTransaction(ctx, func(ctx context.Context, txRepo *RoundsRepository) error {
+		if source != nil {
+			return txRepo.MoveTablePlayer(ctx, source.ID, target.ID, player.ID, seat)
+		}
+
+		if err := txRepo.DeleteBye(ctx, round.ID, player.ID); err != nil {
+			return err
+		}
+
+		return txRepo.SeatPlayer(ctx, target.ID, player.ID, seat)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sortedTables(changed), nil
+}
+
+// seatChangeRound finds the round of a game owned by the caller whose seats may still be changed.
+func (s *RoundsService) seatChangeRound(ctx context.Context, gameID, roundNumber int, sub string) (entity.Game, *entity.Round, error) {
+	gameByID, err := s.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return entity.Game{}, nil, err
+	}
+
+	round, err := findRound(gameByID, roundNumber)
+	if err != nil {
+		return entity.Game{}, nil, err
+	}
+
+	if round.Status == entity.RoundStatusCompleted {
+		return entity.Game{}, nil, apperror.ErrRoundCompleted
+	}
+
+	return gameByID, round, nil
+}
+
+func swapWithBye(ctx context.Context, txRepo *RoundsRepository, roundID, tableID, seatedID, sittingOutID int) error {
+	if err := txRepo.ReplaceTablePlayer(ctx, tableID, seatedID, sittingOutID); err != nil {
+		return err
+	}
+
+	return txRepo.ReplaceBye(ctx, roundID, sittingOutID, seatedID)
+}
+
+// validateSeats checks the players a table would seat after a seat change.
+func validateSeats(table entity.GameTable, players []*entity.Player) error {
+	if len(table.Scores) > 0 {
+		return apperror.ErrTableScored
+	}
+
+	if len(players) > table.Capacity {
+		return apperror.ErrTableFull
+	}
+
+	teams := map[int]bool{}
+
+	for _, player := range players {
+		if player.TeamID == nil {
+			continue
+		}
+
+		if teams[*player.TeamID] {
+			return apperror.ErrTeammatesSeated
+		}
+
+		teams[*player.TeamID] = true
+	}
+
+	return nil
+}
+
+func replacePlayer(players []*entity.Player, playerID int, replacement *entity.Player) []*entity.Player {
+	replaced := slices.Clone(players)
+
+	for i, player := range replaced {
+		if player.ID == playerID {
+			replaced[i] = replacement
+		}
+	}
+
+	return replaced
+}
+
+// replaceSeat hands the seat of a player to the replacing player.
+func replaceSeat(seats []*entity.TablePlayer, playerID, replacementID int) []*entity.TablePlayer {
+	replaced := make([]*entity.TablePlayer, len(seats))
+
+	for i, seat := range seats {
+		replaced[i] = seat
+		if seat.PlayerID == playerID {
+			replaced[i] = &entity.TablePlayer{TableID: seat.TableID, PlayerID: replacementID, Seat: seat.Seat}
+		}
+	}
+
+	return replaced
+}
+
+// nextSeat returns the seat behind the last seated player of a table.
+func nextSeat(table entity.GameTable) int {
+	seat := len(table.Players)
+
+	for _, seated := range table.Seats {
+		seat = max(seat, seated.Seat)
+	}
+
+	return seat + 1
+}
+
+func withPlayers(table entity.GameTable, players []*entity.Player, seats []*entity.TablePlayer) entity.GameTable {
+	table.Players = players
+	table.Seats = seats
+
+	return table
+}
+
+func sortedTables(tables []entity.GameTable) []entity.GameTable {
+	slices.SortFunc(tables, func(a, b entity.GameTable) int {
+		return cmp.Compare(a.TableNumber, b.TableNumber)
+	})
+
+	return tables
+}
+
+func playerOf(game entity.Game, playerID int) *entity.Player {
+	for _, player := range game.AllPlayers() {
+		if player.ID == playerID {
+			return player
+		}
+	}
+
+	return nil
+}
+
+func tableAt(round *entity.Round, tableNumber int) *entity.GameTable {
+	for _, table := range round.Tables {
+		if table.TableNumber == tableNumber {
+			return table
+		}
+	}
+
+	return nil
+}
+
+func sitsOut(round *entity.Round, playerID int) bool {
+	return slices.ContainsFunc(round.Byes, func(bye *entity.RoundBye) bool {
+		return bye.PlayerID == playerID
+	})
+}
@@ -0,0 +1,611 @@
+package round
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/henok321/knobel-manager-service/gen/api"
+	"github.com/henok321/knobel-manager-service/pkg/apperror"
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+	"github.com/henok321/knobel-manager-service/pkg/game"
+	"github.com/henok321/knobel-manager-service/pkg/setup"
+	"github.com/henok321/knobel-manager-service/pkg/standings"
+)
+
+type RoundsService struct {
+	repo         *RoundsRepository
+	gamesService *game.GamesService
+}
+
+func NewRoundsService(repo *RoundsRepository, gamesService *game.GamesService) *RoundsService {
+	return &RoundsService{repo: repo, gamesService: gamesService}
+}
+
+// StartRound puts a round that is still in setup in progress once the game is in progress and all earlier
+// rounds are completed. Only one round of a game can be in progress at a time.
+func (s *RoundsService) StartRound(ctx context.Context, gameID, roundNumber int, sub string) (entity.Round, error) {
+	gameByID, err := s.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return entity.Round{}, err
+	}
+
+	round, err := findRound(gameByID, roundNumber)
+	if err != nil {
+		return entity.Round{}, err
+	}
+
+	if round.Status != entity.RoundStatusSetup {
+		return entity.Round{}, apperror.ErrRoundNotInSetup
+	}
+
+	if gameByID.Status != entity.StatusInProgress {
+		return entity.Round{}, apperror.ErrGameNotInProgress
+	}
+
+	for _, other := range gameByID.Rounds {
+		if other.Status == entity.RoundStatusInProgress {
+			return entity.Round{}, apperror.ErrRoundInProgress
+		}
+
+		if other.RoundNumber < roundNumber && other.Status != entity.RoundStatusCompleted {
+			return entity.Round{}, apperror.ErrRoundNotCompleted
+		}
+	}
+
+	startedRound, err := s.updateStatus(ctx, round, entity.RoundStatusInProgress)
+	if uniqueViolation(err) {
+		// Another round of the game was started concurrently.
+		return entity.Round{}, apperror.ErrRoundInProgress
+	}
+
+	return startedRound, err
+}
+
+// CloseRound completes the round in progress once every seated player has a score.
+func (s *RoundsService) CloseRound(ctx context.Context, gameID, roundNumber int, sub string) (entity.Round, error) {
+	gameByID, err := s.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return entity.Round{}, err
+	}
+
+	round, err := findRound(gameByID, roundNumber)
+	if err != nil {
+		return entity.Round{}, err
+	}
+
+	if round.Status != entity.RoundStatusInProgress {
+		return entity.Round{}, apperror.ErrRoundNotInProgress
+	}
+
+	if scoresMissing(round) {
+		return entity.Round{}, apperror.ErrRoundIncomplete
+	}
+
+	return s.updateStatus(ctx, round, entity.RoundStatusCompleted)
+}
+
+// NextRound draws the next round of a swiss game once all previous rounds are completed,
+// seating players of similar standing together.
+func (s *RoundsService) NextRound(ctx context.Context, gameID int, sub string) (entity.Round, error) {
+	gameByID, err := s.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return entity.Round{}, err
+	}
+
+	if len(gameByID.Stages) > 0 {
+		return s.nextStageRound(ctx, gameByID)
+	}
+
+	if gameByID.PairingMode != entity.PairingSwiss {
+		return entity.Round{}, apperror.ErrNotSwissPairing
+	}
+
+	if len(gameByID.Rounds) == 0 {
+		return entity.Round{}, apperror.ErrInvalidGameSetup
+	}
+
+	if len(gameByID.Rounds) >= gameByID.NumberOfRounds {
+		return entity.Round{}, apperror.ErrAllRoundsDrawn
+	}
+
+	for _, previous := range gameByID.Rounds {
+		if previous.Status != entity.RoundStatusCompleted {
+			return entity.Round{}, apperror.ErrRoundNotCompleted
+		}
+	}
+
+	teamSetup := game.NewTeamSetup(gameByID)
+
+	tables, err := setup.AssignTablesByRanking(teamSetup, playerRanking(gameByID, 0))
+	if err != nil {
+		return entity.Round{}, apperror.ErrTableAssignment
+	}
+
+	game.SeatTables(gameByID, len(gameByID.Rounds)+1, tables)
+
+	return s.gamesService.CreateRound(ctx, gameID, len(gameByID.Rounds)+1, nil, teamSetup, tables)
+}
+
+// nextStageRound draws the next round of the swiss stage in progress once all its rounds are completed,
+// seating players of similar standing in the stage together within their group.
+func (s *RoundsService) nextStageRound(ctx context.Context, gameByID entity.Game) (entity.Round, error) {
+	stage := game.CurrentStage(gameByID)
+	if stage == nil {
+		return entity.Round{}, apperror.ErrInvalidGameSetup
+	}
+
+	if stage.PairingMode != entity.PairingSwiss {
+		return entity.Round{}, apperror.ErrNotSwissPairing
+	}
+
+	stageRounds := game.StageRounds(gameByID, stage.ID)
+	if len(stageRounds) >= stage.NumberOfRounds {
+		return entity.Round{}, apperror.ErrAllRoundsDrawn
+	}
+
+	for _, previous := range stageRounds {
+		if previous.Status != entity.RoundStatusCompleted {
+			return entity.Round{}, apperror.ErrRoundNotCompleted
+		}
+	}
+
+	ranking := rankedPlayerIDs(standings.CalculateStage(gameByID, *stage, 0))
+
+	groups := make([]setup.TeamsPlayersMapping, 0, stage.Groups)
+
+	for _, groupSetup := range game.NewStageSetups(gameByID, *stage) {
+		tables, err := setup.AssignTablesByRanking(groupSetup, ranking)
+		if err != nil {
+			return entity.Round{}, apperror.ErrTableAssignment
+		}
+
+		groups = append(groups, tables)
+	}
+
+	roundNumber := 1
+	for _, round := range gameByID.Rounds {
+		roundNumber = max(roundNumber, round.RoundNumber+1)
+	}
+
+	tables := setup.MergeTables(groups)
+	game.SeatTables(gameByID, roundNumber, tables)
+
+	return s.gamesService.CreateRound(ctx, gameByID.ID, roundNumber, &stage.ID, game.NewStageSetup(gameByID, *stage), tables)
+}
+
+// ReassignRound draws the tables of a round again while all other rounds stay untouched. The round
+// must not have any scores yet. Rounds of swiss games after the first are drawn from the standings
+// before the round; all other rounds avoid the encounters of the remaining rounds. Substitutions made
+// in the round are kept: every substitute is drawn in place of the player they replace.
+func (s *RoundsService) ReassignRound(ctx context.Context, gameID, roundNumber int, sub string, seed *int64) (entity.Round, error) {
+	gameByID, err := s.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return entity.Round{}, err
+	}
+
+	round, err := findRound(gameByID, roundNumber)
+	if err != nil {
+		return entity.Round{}, err
+	}
+
+	if round.Status == entity.RoundStatusCompleted {
+		return entity.Round{}, apperror.ErrRoundCompleted
+	}
+
+	for _, table := range round.Tables {
+		if len(table.Scores) > 0 {
+			return entity.Round{}, apperror.ErrRoundScored
+		}
+	}
+
+	drawSeed := time.Now().Unix()
+	if seed != nil {
+		drawSeed = *seed
+	}
+
+	var (
+		teamSetup setup.TeamSetup
+		tables    setup.TeamsPlayersMapping
+	)
+
+	if round.StageID != nil {
+		teamSetup, tables, err = redrawStageRound(gameByID, *round, drawSeed)
+	} else {
+		teamSetup, tables, err = redrawRound(gameByID, *round, drawSeed)
+	}
+
+	if err != nil {
+		return entity.Round{}, err
+	}
+
+	game.SeatTables(gameByID, roundNumber, tables)
+
+	if err := s.gamesService.RedrawRound(ctx, *round, teamSetup, tables); err != nil {
+		return entity.Round{}, err
+	}
+
+	round.Byes = nil
+	for _, playerID := range setup.Byes(teamSetup, tables) {
+		round.Byes = append(round.Byes, &entity.RoundBye{RoundID: round.ID, PlayerID: playerID})
+	}
+
+	return *round, nil
+}
+
+// Substitute replaces a seated player by a teammate at their table of the given round, or of the given
+// and every later round that is not completed. The substitute is either a player of the team who does
+// not play in these rounds or a new player of the team. Rounds in which the player sits out stay
+// unchanged, and scores of earlier rounds stay with the players who played them. Every replaced seat
+// is recorded as a substitution.
+func (s *RoundsService) Substitute(ctx context.Context, gameID, roundNumber int, sub string, request api.SubstitutionRequest) ([]entity.Substitution, error) {
+	gameByID, err := s.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return nil, err
+	}
+
+	round, err := findRound(gameByID, roundNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	if round.Status == entity.RoundStatusCompleted {
+		return nil, apperror.ErrRoundCompleted
+	}
+
+	if gameByID.Mode == entity.GameModeIndividual {
+		return nil, apperror.ErrGameModeMismatch
+	}
+
+	// Swiss rounds drawn later seat the team roster again, so the substitute would not stay in the game.
+	if request.Scope == api.Remaining && swissPairing(gameByID, *round) {
+		return nil, apperror.ErrSwissSubstitution
+	}
+
+	team := teamOf(gameByID, request.PlayerID)
+	if team == nil {
+		return nil, apperror.ErrPlayerNotFound
+	}
+
+	rounds := []*entity.Round{round}
+
+	if request.Scope == api.Remaining {
+		for _, later := range gameByID.Rounds {
+			if later.RoundNumber > roundNumber && later.Status != entity.RoundStatusCompleted {
+				rounds = append(rounds, later)
+			}
+		}
+
+		slices.SortFunc(rounds, func(a, b *entity.Round) int { return cmp.Compare(a.RoundNumber, b.RoundNumber) })
+	}
+
+	substitutions := make([]entity.Substitution, 0, len(rounds))
+
+	for _, affected := range rounds {
+		table := seatedAt(affected, request.PlayerID)
+		if table == nil {
+			if affected == round {
+				return nil, apperror.ErrPlayerNotSeated
+			}
+
+			continue
+		}
+
+		if slices.ContainsFunc(table.Scores, func(score *entity.Score) bool { return score.PlayerID == request.PlayerID }) {
+			return nil, apperror.ErrPlayerAlreadyScored
+		}
+
+		substitutions = append(substitutions, entity.Substitution{RoundID: affected.ID, Round: affected, TableID: table.ID, Table: table, PlayerID: request.PlayerID})
+	}
+
+	substitute := entity.Player{TeamID: &team.ID, Substitute: true}
+
+	if request.SubstituteID != nil {
+		existing := slices.IndexFunc(team.Players, func(player *entity.Player) bool { return player.ID == *request.SubstituteID })
+		if existing < 0 || *request.SubstituteID == request.PlayerID {
+			return nil, apperror.ErrInvalidSubstitute
+		}
+
+		for _, affected := range rounds {
+			if seatedAt(affected, *request.SubstituteID) != nil || slices.ContainsFunc(affected.Byes, func(bye *entity.RoundBye) bool { return bye.PlayerID == *request.SubstituteID }) {
+				return nil, apperror.ErrInvalidSubstitute
+			}
+		}
+
+		substitute = *team.Players[existing]
+	} else if request.SubstituteName != nil {
+		substitute.Name = *request.SubstituteName
+	}
+
+	err = s.repo.WithinTransaction(ctx, func(ctx context.Context, txRepo *RoundsRepository) error {
+		if substitute.ID == 0 {
+			if err := txRepo.CreatePlayer(ctx, &substitute); err != nil {
+				return err
+			}
+		}
+
+		for i := range substitutions {
+			substitutions[i].SubstituteID = substitute.ID
+
+			if err := txRepo.ReplaceTablePlayer(ctx, substitutions[i].TableID, request.PlayerID, substitute.ID); err != nil {
+				return err
+			}
+		}
+
+		return txRepo.CreateSubstitutions(ctx, substitutions)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return substitutions, nil
+}
+
+// Substitutions lists the substitutions of a game in the order they were made.
+func (s *RoundsService) Substitutions(ctx context.Context, gameID int, sub string) ([]entity.Substitution, error) {
+	if _, err := s.gamesService.FindByID(ctx, gameID, sub); err != nil {
+		return nil, err
+	}
+
+	return s.repo.FindSubstitutions(ctx, gameID)
+}
+
+// redrawRound draws the tables of a round of a game not played in stages.
+func redrawRound(gameByID entity.Game, round entity.Round, seed int64) (setup.TeamSetup, setup.TeamsPlayersMapping, error) {
+	roundNumber := round.RoundNumber
+
+	teamSetup := game.NewTeamSetup(gameByID)
+	substituteTeams(teamSetup.Teams, round.Substitutions)
+
+	if _, ok := setup.PlanTables(teamSetup); !ok {
+		return setup.TeamSetup{}, nil, apperror.ErrInvalidGameSetup
+	}
+
+	var (
+		tables setup.TeamsPlayersMapping
+		err    error
+	)
+
+	if gameByID.PairingMode == entity.PairingSwiss && roundNumber > 1 {
+		tables, err = setup.AssignTablesByRanking(teamSetup, substituteRanking(playerRanking(gameByID, roundNumber-1), round.Substitutions))
+	} else {
+		tables, err = setup.AssignRound(teamSetup, otherRounds(gameByID.Rounds, roundNumber), seed)
+	}
+
+	if err != nil {
+		return setup.TeamSetup{}, nil, apperror.ErrTableAssignment
+	}
+
+	return teamSetup, tables, nil
+}
+
+// redrawStageRound draws the tables of a round of a stage for every group of the stage. Rounds of swiss
+// stages after the first are drawn from the stage standings before the round; all other rounds avoid
+// the encounters of the remaining rounds of the stage.
+func redrawStageRound(gameByID entity.Game, round entity.Round, seed int64) (setup.TeamSetup, setup.TeamsPlayersMapping, error) {
+	index := slices.IndexFunc(gameByID.Stages, func(stage *entity.Stage) bool { return stage.ID == *round.StageID })
+	if index < 0 {
+		return setup.TeamSetup{}, nil, apperror.ErrStageNotFound
+	}
+
+	stage := *gameByID.Stages[index]
+	stageRounds := game.StageRounds(gameByID, stage.ID)
+
+	earlierRounds := slices.ContainsFunc(stageRounds, func(other *entity.Round) bool { return other.RoundNumber < round.RoundNumber })
+
+	var ranking []int
+	if stage.PairingMode == entity.PairingSwiss && earlierRounds {
+		ranking = substituteRanking(rankedPlayerIDs(standings.CalculateStage(gameByID, stage, round.RoundNumber-1)), round.Substitutions)
+	}
+
+	groups := make([]setup.TeamsPlayersMapping, 0, stage.Groups)
+
+	for _, groupSetup := range game.NewStageSetups(gameByID, stage) {
+		substituteTeams(groupSetup.Teams, round.Substitutions)
+
+		if _, ok := setup.PlanTables(groupSetup); !ok {
+			return setup.TeamSetup{}, nil, apperror.ErrInvalidGameSetup
+		}
+
+		var (
+			tables setup.TeamsPlayersMapping
+			err    error
+		)
+
+		if ranking != nil {
+			tables, err = setup.AssignTablesByRanking(groupSetup, ranking)
+		} else {
+			tables, err = setup.AssignRound(groupSetup, groupRounds(stageRounds, round.RoundNumber, groupSetup), seed)
+		}
+
+		if err != nil {
+			return setup.TeamSetup{}, nil, apperror.ErrTableAssignment
+		}
+
+		groups = append(groups, tables)
+	}
+
+	teamSetup := game.NewStageSetup(gameByID, stage)
+	substituteTeams(teamSetup.Teams, round.Substitutions)
+
+	return teamSetup, setup.MergeTables(groups), nil
+}
+
+// substituteTeams replaces the players substituted in a round by their substitutes, so that a redraw of
+// the round seats the substitutes instead.
+func substituteTeams(teams map[int][]int, substitutions []*entity.Substitution) {
+	for _, substitution := range substitutions {
+		for teamID, members := range teams {
+			if !slices.Contains(members, substitution.PlayerID) {
+				continue
+			}
+
+			members = slices.DeleteFunc(slices.Clone(members), func(id int) bool { return id == substitution.PlayerID })
+			if !slices.Contains(members, substitution.SubstituteID) {
+				members = append(members, substitution.SubstituteID)
+				slices.Sort(members)
+			}
+
+			teams[teamID] = members
+		}
+	}
+}
+
+// substituteRanking puts every substitute of a round at the place of the player they replace, unless
+// the substitute is ranked already.
+func substituteRanking(ranking []int, substitutions []*entity.Substitution) []int {
+	ranking = slices.Clone(ranking)
+
+	for _, substitution := range substitutions {
+		if slices.Contains(ranking, substitution.SubstituteID) {
+			continue
+		}
+
+		if index := slices.Index(ranking, substitution.PlayerID); index >= 0 {
+			ranking[index] = substitution.SubstituteID
+		}
+	}
+
+	return ranking
+}
+
+func (s *RoundsService) updateStatus(ctx context.Context, round *entity.Round, status entity.RoundStatus) (entity.Round, error) {
+	if err := s.repo.UpdateStatus(ctx, round.ID, status); err != nil {
+		return entity.Round{}, err
+	}
+
+	round.Status = status
+
+	return *round, nil
+}
+
+// playerRanking lists the player IDs of a game from first to last place after the given round.
+func playerRanking(gameByID entity.Game, afterRound int) []int {
+	return rankedPlayerIDs(standings.Calculate(gameByID, afterRound))
+}
+
+// rankedPlayerIDs lists the player IDs of the standings from first to last place.
+func rankedPlayerIDs(current standings.Standings) []int {
+	ranking := make([]int, len(current.Players))
+	for i, player := range current.Players {
+		ranking[i] = player.PlayerID
+	}
+
+	return ranking
+}
+
+// groupRounds returns the persisted tables of the given rounds except the given one seating the
+// players of a group, with every player counted for their team in the group setup.
+func groupRounds(stageRounds []*entity.Round, roundNumber int, groupSetup setup.TeamSetup) []setup.TeamsPlayersMapping {
+	teams := map[int]int{}
+	for teamID, members := range groupSetup.Teams {
+		for _, playerID := range members {
+			teams[playerID] = teamID
+		}
+	}
+
+	rounds := make([]setup.TeamsPlayersMapping, 0, len(stageRounds))
+
+	for _, tables := range otherRounds(stageRounds, roundNumber) {
+		groupTables := setup.TeamsPlayersMapping{}
+
+		for tableNumber, players := range tables {
+			for _, player := range players {
+				if teamID, ok := teams[player.ID]; ok {
+					groupTables[tableNumber] = append(groupTables[tableNumber], setup.Player{ID: player.ID, TeamID: teamID})
+				}
+			}
+		}
+
+		rounds = append(rounds, groupTables)
+	}
+
+	return rounds
+}
+
+// otherRounds returns the persisted tables of every given round except the given one.
+func otherRounds(gameRounds []*entity.Round, roundNumber int) []setup.TeamsPlayersMapping {
+	rounds := make([]setup.TeamsPlayersMapping, 0, len(gameRounds))
+
+	for _, round := range gameRounds {
+		if round.RoundNumber == roundNumber {
+			continue
+		}
+
+		tables := setup.TeamsPlayersMapping{}
+
+		for _, table := range round.Tables {
+			for _, player := range table.Players {
+				tables[table.TableNumber] = append(tables[table.TableNumber], game.DrawPlayer(player))
+			}
+		}
+
+		rounds = append(rounds, tables)
+	}
+
+	return rounds
+}
+
+func findRound(game entity.Game, roundNumber int) (*entity.Round, error) {
+	for _, round := range game.Rounds {
+		if round.RoundNumber == roundNumber {
+			return round, nil
+		}
+	}
+
+	return nil, apperror.ErrRoundNotFound
+}
+
+func teamOf(game entity.Game, playerID int) *entity.Team {
+	for _, team := range game.Teams {
+		for _, player := range team.Players {
+			if player.ID == playerID {
+				return team
+			}
+		}
+	}
+
+	return nil
+}
+
+// swissPairing reports whether the further rounds after the given round are drawn from the standings,
+// either by the game or by the stage of the round.
+func swissPairing(gameByID entity.Game, round entity.Round) bool {
+	if round.StageID == nil {
+		return gameByID.PairingMode == entity.PairingSwiss
+	}
+
+	index := slices.IndexFunc(gameByID.Stages, func(stage *entity.Stage) bool { return stage.ID == *round.StageID })
+
+	return index >= 0 && gameByID.Stages[index].PairingMode == entity.PairingSwiss
+}
+
+func seatedAt(round *entity.Round, playerID int) *entity.GameTable {
+	for _, table := range round.Tables {
+		for _, player := range table.Players {
+			if player.ID == playerID {
+				return table
+			}
+		}
+	}
+
+	return nil
+}
+
+func scoresMissing(round *entity.Round) bool {
+	for _, table := range round.Tables {
+		scored := make(map[int]bool, len(table.Scores))
+		for _, score := range table.Scores {
+			scored[score.PlayerID] = true
+		}
+
+		for _, player := range table.Players {
+			if !scored[player.ID] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,138 @@
+package setup
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// AssignTablesByRanking seats players of similar standing together: the best ranked players fill
+// the first table, the next ones the second table and so on. A player is skipped for a table while
+// a teammate already sits there. Players missing from the ranking are seated last, ordered by ID.
+// If the layout has byes, the lowest ranked players sit out the round.
+func AssignTablesByRanking(teamSetup TeamSetup, ranking []int) (TeamsPlayersMapping, error) {
+	layout, ok := PlanTables(teamSetup)
+	if !ok {
+		return nil, fmt.Errorf("invalid setup: teams=%d teamSize=%d tableSize=%d", len(teamSetup.Teams), teamSetup.TeamSize, teamSetup.TableSize)
+	}
+
+	playersByID := map[int]Player{}
+	for teamID, memberIDs := range teamSetup.Teams {
+		for _, id := range memberIDs {
+			playersByID[id] = Player{ID: id, TeamID: teamID}
+		}
+	}
+
+	ordered := make([]Player, 0, len(playersByID))
+	for _, id := range ranking {
+		if player, ok := playersByID[id]; ok {
+			ordered = append(ordered, player)
+			delete(playersByID, id)
+		}
+	}
+
+	unranked := make([]int, 0, len(playersByID))
+	for id := range playersByID {
+		unranked = append(unranked, id)
+	}
+
+	sort.Ints(unranked)
+
+	for _, id := range unranked {
+		ordered = append(ordered, playersByID[id])
+	}
+
+	ordered = ordered[:len(ordered)-layout.Byes]
+
+	seating := rankedSeating{
+		players:        ordered,
+		seated:         make([]bool, len(ordered)),
+		layout:         layout,
+		tableSize:      teamSetup.TableSize,
+		numberOfTables: layout.Tables(),
+		teamsLeft:      map[int]int{},
+	}
+
+	seating.tables = make(TeamsPlayersMapping, seating.numberOfTables)
+	for i := range seating.numberOfTables {
+		seating.tables[i] = make([]Player, 0, teamSetup.TableSize)
+	}
+
+	for _, player := range ordered {
+		seating.teamsLeft[player.TeamID]++
+	}
+
+	if !seating.seat(0) {
+		return nil, fmt.Errorf("no seating by ranking found: teams=%d teamSize=%d tableSize=%d", len(teamSetup.Teams), teamSetup.TeamSize, teamSetup.TableSize)
+	}
+
+	return seating.tables, nil
+}
+
+type rankedSeating struct {
+	players        []Player
+	seated         []bool
+	layout         Layout
+	tableSize      int
+	numberOfTables int
+	tables         TeamsPlayersMapping
+	teamsLeft      map[int]int
+}
+
+// seat fills the tables from the given one onwards with the best ranked players left and
+// backtracks if the remaining players can no longer be seated without teammates together.
+func (s *rankedSeating) seat(table int) bool {
+	if table == s.numberOfTables {
+		return true
+	}
+
+	if len(s.tables[table]) == s.layout.capacity(table, s.tableSize) {
+		return s.seat(table + 1)
+	}
+
+	for i, player := range s.players {
+		if s.seated[i] || hasTeam(s.tables[table], player.TeamID) {
+			continue
+		}
+
+		s.tables[table] = append(s.tables[table], player)
+		s.seated[i] = true
+		s.teamsLeft[player.TeamID]--
+
+		if s.feasible(table) && s.seat(table) {
+			return true
+		}
+
+		s.tables[table] = s.tables[table][:len(s.tables[table])-1]
+		s.seated[i] = false
+		s.teamsLeft[player.TeamID]++
+	}
+
+	return false
+}
+
+// feasible reports whether every team still has enough tables left to seat its remaining players
+// at most once per table.
+func (s *rankedSeating) feasible(table int) bool {
+	tablesAfter := s.numberOfTables - table - 1
+	open := len(s.tables[table]) < s.layout.capacity(table, s.tableSize)
+
+	for teamID, left := range s.teamsLeft {
+		slots := tablesAfter
+		if open && !hasTeam(s.tables[table], teamID) {
+			slots++
+		}
+
+		if left > slots {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasTeam(table []Player, teamID int) bool {
+	return slices.ContainsFunc(table, func(p Player) bool {
+		return p.TeamID == teamID
+	})
+}
@@ -0,0 +1,133 @@
+package setup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignTablesByRanking(t *testing.T) {
+	teams := map[int][]int{
+		1: {1, 2},
+		2: {3, 4},
+		3: {5, 6},
+		4: {7, 8},
+	}
+
+	tests := []struct {
+		name     string
+		ranking  []int
+		expected TeamsPlayersMapping
+	}{
+		{
+			name:    "top ranked players share the first table",
+			ranking: []int{1, 3, 5, 7, 2, 4, 6, 8},
+			expected: TeamsPlayersMapping{
+				0: {{ID: 1, TeamID: 1}, {ID: 3, TeamID: 2}},
+				1: {{ID: 5, TeamID: 3}, {ID: 7, TeamID: 4}},
+				2: {{ID: 2, TeamID: 1}, {ID: 4, TeamID: 2}},
+				3: {{ID: 6, TeamID: 3}, {ID: 8, TeamID: 4}},
+			},
+		},
+		{
+			name:    "teammates ranked next to each other are split",
+			ranking: []int{1, 2, 3, 4, 5, 6, 7, 8},
+			expected: TeamsPlayersMapping{
+				0: {{ID: 1, TeamID: 1}, {ID: 3, TeamID: 2}},
+				1: {{ID: 2, TeamID: 1}, {ID: 4, TeamID: 2}},
+				2: {{ID: 5, TeamID: 3}, {ID: 7, TeamID: 4}},
+				3: {{ID: 6, TeamID: 3}, {ID: 8, TeamID: 4}},
+			},
+		},
+		{
+			name:    "unranked players are seated last",
+			ranking: []int{8, 6},
+			expected: TeamsPlayersMapping{
+				0: {{ID: 8, TeamID: 4}, {ID: 6, TeamID: 3}},
+				1: {{ID: 1, TeamID: 1}, {ID: 3, TeamID: 2}},
+				2: {{ID: 2, TeamID: 1}, {ID: 4, TeamID: 2}},
+				3: {{ID: 5, TeamID: 3}, {ID: 7, TeamID: 4}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AssignTablesByRanking(TeamSetup{Teams: teams, TeamSize: 2, TableSize: 2}, tt.ranking)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestAssignTablesByRankingBacktracks(t *testing.T) {
+	teams := map[int][]int{
+		1: {1, 2, 3, 4},
+		2: {5, 6, 7, 8},
+		3: {9, 10, 11, 12},
+		4: {13, 14, 15, 16},
+		5: {17, 18, 19, 20},
+		6: {21, 22, 23, 24},
+		7: {25, 26, 27, 28},
+		8: {29, 30, 31, 32},
+	}
+
+	// Teams 1 and 2 lead the ranking, so a plain greedy fill would leave their last players for the same table.
+	ranking := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+	got, err := AssignTablesByRanking(TeamSetup{Teams: teams, TeamSize: 4, TableSize: 4}, ranking)
+	require.NoError(t, err)
+
+	seen := map[int]bool{}
+
+	for _, table := range got {
+		require.Len(t, table, 4)
+
+		teamsAtTable := map[int]bool{}
+
+		for _, player := range table {
+			assert.False(t, teamsAtTable[player.TeamID], "teammates must not share a table")
+			assert.False(t, seen[player.ID], "every player is seated once")
+
+			teamsAtTable[player.TeamID] = true
+			seen[player.ID] = true
+		}
+	}
+
+	assert.Len(t, seen, 32)
+}
+
+func TestAssignTablesByRankingInvalidSetup(t *testing.T) {
+	_, err := AssignTablesByRanking(TeamSetup{Teams: map[int][]int{1: {1, 2}, 2: {3}}, TeamSize: 2, TableSize: 2}, nil)
+
+	require.Error(t, err)
+}
+
+func TestAssignTablesByRankingByes(t *testing.T) {
+	teams := map[int][]int{1: {1, 2}, 2: {3, 4}, 3: {5}}
+	teamSetup := TeamSetup{Teams: teams, TeamSize: 2, TableSize: 2, Byes: true}
+
+	got, err := AssignTablesByRanking(teamSetup, []int{1, 3, 2, 5, 4})
+	require.NoError(t, err)
+
+	assert.Equal(t, TeamsPlayersMapping{
+		0: {{ID: 1, TeamID: 1}, {ID: 3, TeamID: 2}},
+		1: {{ID: 2, TeamID: 1}, {ID: 5, TeamID: 3}},
+	}, got)
+	assert.Equal(t, []int{4}, Byes(teamSetup, got), "the lowest ranked player sits out")
+}
+
+func TestAssignTablesByRankingShortTables(t *testing.T) {
+	teams := map[int][]int{1: {1, 2}, 2: {3, 4}, 3: {5}}
+	teamSetup := TeamSetup{Teams: teams, TeamSize: 2, TableSize: 3, ShortTables: true}
+
+	got, err := AssignTablesByRanking(teamSetup, []int{1, 3, 2, 5, 4})
+	require.NoError(t, err)
+
+	assert.Equal(t, TeamsPlayersMapping{
+		0: {{ID: 1, TeamID: 1}, {ID: 3, TeamID: 2}, {ID: 5, TeamID: 3}},
+		1: {{ID: 2, TeamID: 1}, {ID: 4, TeamID: 2}},
+	}, got)
+}
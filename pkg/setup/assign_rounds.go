@@ -0,0 +1,265 @@
+package setup
+
+import (
+	"math/rand"
+	"slices"
+)
+
+// Algorithm identifies the draw implemented by AssignRounds. It changes whenever the same seed
+// would no longer produce the same tables.
+const Algorithm = "assign-rounds-v1"
+
+const (
+	playerEncounterWeight = 10
+	teamEncounterWeight   = 1
+	byeWeight             = 100
+	iterationsPerPlayer   = 500
+	maxIterations         = 200_000
+)
+
+// Quality describes how evenly the encounters of a draw are spread over all rounds.
+type Quality struct {
+	// PlayerRepeats counts the encounters of player pairs beyond their first one.
+	PlayerRepeats       int
+	MaxPlayerEncounters int
+	// TeamRepeats counts the encounters of team pairs beyond their first one.
+	TeamRepeats       int
+	MaxTeamEncounters int
+	// Cost is the weighted penalty of repeated encounters; lower is better.
+	Cost int
+}
+
+// AssignRounds draws the tables of all rounds jointly. Every round starts from AssignTables and is
+// then improved by swapping players between tables of the same round, keeping a swap whenever it
+// does not increase how often the same players or teams meet. Players sitting out a round may be
+// swapped with seated ones as well, so that byes are spread over different players. The search runs
+// a bounded number of iterations and is deterministic for a given seed.
+func AssignRounds(teamSetup TeamSetup, numberOfRounds int, seed int64) ([]TeamsPlayersMapping, Quality, error) {
+	rounds := make([]TeamsPlayersMapping, numberOfRounds)
+	byes := make([][]Player, numberOfRounds)
+
+	for i := range numberOfRounds {
+		tables, err := AssignTables(teamSetup, seed+int64(i))
+		if err != nil {
+			return nil, Quality{}, err
+		}
+
+		rounds[i] = tables
+		byes[i] = sittingOut(teamSetup, tables)
+	}
+
+	if numberOfRounds > 1 {
+		movable := make([]int, numberOfRounds)
+		for i := range movable {
+			movable[i] = i
+		}
+
+		optimise(rounds, byes, movable, seed, iterations(teamSetup))
+	}
+
+	return rounds, EvaluateRounds(rounds), nil
+}
+
+// AssignRound draws the tables of a single round next to the given rounds, which stay unchanged.
+// Like AssignRounds it starts from AssignTables and swaps players between the tables of the new
+// round whenever that does not increase how often the same players or teams meet over all rounds.
+func AssignRound(teamSetup TeamSetup, others []TeamsPlayersMapping, seed int64) (TeamsPlayersMapping, error) {
+	tables, err := AssignTables(teamSetup, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	rounds := append(slices.Clone(others), tables)
+
+	byes := make([][]Player, len(rounds))
+	for i, round := range rounds {
+		byes[i] = sittingOut(teamSetup, round)
+	}
+
+	if len(others) > 0 {
+		optimise(rounds, byes, []int{len(others)}, seed, iterations(teamSetup))
+	}
+
+	return tables, nil
+}
+
+// EvaluateRounds rates the player and team encounters of the given rounds.
+func EvaluateRounds(rounds []TeamsPlayersMapping) Quality {
+	counter := newEncounters()
+
+	for _, tables := range rounds {
+		for _, table := range tables {
+			counter.apply(table, 1)
+		}
+	}
+
+	quality := Quality{Cost: counter.cost}
+
+	for _, meetings := range counter.players {
+		quality.PlayerRepeats += max(meetings-1, 0)
+		quality.MaxPlayerEncounters = max(quality.MaxPlayerEncounters, meetings)
+	}
+
+	for _, meetings := range counter.teams {
+		quality.TeamRepeats += max(meetings-1, 0)
+		quality.MaxTeamEncounters = max(quality.MaxTeamEncounters, meetings)
+	}
+
+	return quality
+}
+
+func iterations(teamSetup TeamSetup) int {
+	return min(len(teamSetup.Teams)*teamSetup.TeamSize*iterationsPerPlayer, maxIterations)
+}
+
+// optimise improves the tables of the movable rounds; the remaining rounds only count towards the encounters.
+func optimise(rounds []TeamsPlayersMapping, byes [][]Player, movable []int, seed int64, iterations int) {
+	counter := newEncounters()
+
+	for i, tables := range rounds {
+		for _, table := range tables {
+			counter.apply(table, 1)
+		}
+
+		for _, player := range byes[i] {
+			counter.sitOut(player, 1)
+		}
+	}
+
+	rnd := rand.New(rand.NewSource(seed)) //nolint:gosec // G404: deterministic seeded search for table assignment, not security-sensitive
+
+	for range iterations {
+		round := movable[rnd.Intn(len(movable))]
+		tables := rounds[round]
+
+		if len(byes[round]) > 0 && rnd.Intn(len(tables)+1) == 0 {
+			swapBye(counter, tables, byes[round], rnd)
+			continue
+		}
+
+		if len(tables) < 2 {
+			continue
+		}
+
+		a := rnd.Intn(len(tables))
+		b := rnd.Intn(len(tables) - 1)
+
+		if b >= a {
+			b++
+		}
+
+		i := rnd.Intn(len(tables[a]))
+		j := rnd.Intn(len(tables[b]))
+
+		if !swappable(tables[a], tables[b], i, j) {
+			continue
+		}
+
+		before := counter.cost
+
+		counter.apply(tables[a], -1)
+		counter.apply(tables[b], -1)
+		tables[a][i], tables[b][j] = tables[b][j], tables[a][i]
+		counter.apply(tables[a], 1)
+		counter.apply(tables[b], 1)
+
+		if counter.cost > before {
+			counter.apply(tables[a], -1)
+			counter.apply(tables[b], -1)
+			tables[a][i], tables[b][j] = tables[b][j], tables[a][i]
+			counter.apply(tables[a], 1)
+			counter.apply(tables[b], 1)
+		}
+	}
+}
+
+// swapBye exchanges a random seated player with a random player sitting out the round, unless the
+// swap seats teammates together or increases the cost.
+func swapBye(counter *encounters, tables TeamsPlayersMapping, byes []Player, rnd *rand.Rand) {
+	a := rnd.Intn(len(tables))
+	i := rnd.Intn(len(tables[a]))
+	k := rnd.Intn(len(byes))
+
+	if tables[a][i].TeamID != byes[k].TeamID && hasTeam(tables[a], byes[k].TeamID) {
+		return
+	}
+
+	swap := func() {
+		counter.apply(tables[a], -1)
+		counter.sitOut(tables[a][i], 1)
+		counter.sitOut(byes[k], -1)
+		tables[a][i], byes[k] = byes[k], tables[a][i]
+		counter.apply(tables[a], 1)
+	}
+
+	before := counter.cost
+
+	swap()
+
+	if counter.cost > before {
+		swap()
+	}
+}
+
+// swappable reports whether exchanging seat i of table a with seat j of table b keeps teammates apart.
+func swappable(a, b []Player, i, j int) bool {
+	if a[i].TeamID == b[j].TeamID {
+		return true
+	}
+
+	return !hasTeam(a, b[j].TeamID) && !hasTeam(b, a[i].TeamID)
+}
+
+type encounters struct {
+	players map[[2]int]int
+	teams   map[[2]int]int
+	byes    map[int]int
+	cost    int
+}
+
+func newEncounters() *encounters {
+	return &encounters{players: map[[2]int]int{}, teams: map[[2]int]int{}, byes: map[int]int{}}
+}
+
+// sitOut adds (delta 1) or removes (delta -1) a bye of a player. Like repeated encounters, every
+// further bye of the same player weighs more than the last.
+func (e *encounters) sitOut(player Player, delta int) {
+	before := e.byes[player.ID]
+	e.byes[player.ID] = before + delta
+
+	if delta > 0 {
+		e.cost += byeWeight * before
+	} else {
+		e.cost -= byeWeight * (before - 1)
+	}
+}
+
+// apply adds (delta 1) or removes (delta -1) the encounters of one table and keeps the cost in sync.
+// Every pair that meets m times costs m*(m-1)/2, so each repeated encounter weighs more than the last.
+func (e *encounters) apply(table []Player, delta int) {
+	for i := range table {
+		for j := i + 1; j < len(table); j++ {
+			e.cost += playerEncounterWeight * e.count(e.players, pairKey(table[i].ID, table[j].ID), delta)
+			e.cost += teamEncounterWeight * e.count(e.teams, pairKey(table[i].TeamID, table[j].TeamID), delta)
+		}
+	}
+}
+
+func (e *encounters) count(meetings map[[2]int]int, key [2]int, delta int) int {
+	before := meetings[key]
+	meetings[key] = before + delta
+
+	if delta > 0 {
+		return before
+	}
+
+	return -(before - 1)
+}
+
+func pairKey(a, b int) [2]int {
+	if a > b {
+		a, b = b, a
+	}
+
+	return [2]int{a, b}
+}
@@ -0,0 +1,163 @@
+package setup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func eightTeams() TeamSetup {
+	return TeamSetup{
+		Teams: map[int][]int{
+			1: {1, 2, 3, 4},
+			2: {5, 6, 7, 8},
+			3: {9, 10, 11, 12},
+			4: {13, 14, 15, 16},
+			5: {17, 18, 19, 20},
+			6: {21, 22, 23, 24},
+			7: {25, 26, 27, 28},
+			8: {29, 30, 31, 32},
+		},
+		TeamSize:  4,
+		TableSize: 4,
+	}
+}
+
+func TestAssignRounds(t *testing.T) {
+	teamSetup := eightTeams()
+
+	rounds, quality, err := AssignRounds(teamSetup, 4, 1)
+	require.NoError(t, err)
+	require.Len(t, rounds, 4)
+
+	for _, tables := range rounds {
+		seated := map[int]bool{}
+
+		for _, table := range tables {
+			require.Len(t, table, teamSetup.TableSize)
+
+			teams := map[int]bool{}
+
+			for _, player := range table {
+				assert.False(t, teams[player.TeamID], "teammates must not share a table")
+				assert.False(t, seated[player.ID], "every player is seated once per round")
+
+				teams[player.TeamID] = true
+				seated[player.ID] = true
+			}
+		}
+
+		assert.Len(t, seated, 32)
+	}
+
+	assert.Equal(t, EvaluateRounds(rounds), quality)
+
+	independent := make([]TeamsPlayersMapping, 4)
+	for i := range independent {
+		independent[i], err = AssignTables(teamSetup, int64(1+i))
+		require.NoError(t, err)
+	}
+
+	baseline := EvaluateRounds(independent)
+
+	assert.LessOrEqual(t, quality.Cost, baseline.Cost)
+	assert.Less(t, quality.PlayerRepeats, baseline.PlayerRepeats, "the joint search reduces repeated encounters")
+}
+
+func TestAssignRoundsIsDeterministic(t *testing.T) {
+	first, firstQuality, err := AssignRounds(eightTeams(), 3, 42)
+	require.NoError(t, err)
+
+	second, secondQuality, err := AssignRounds(eightTeams(), 3, 42)
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.Equal(t, firstQuality, secondQuality)
+}
+
+func TestAssignRoundsSingleRoundMatchesAssignTables(t *testing.T) {
+	rounds, _, err := AssignRounds(eightTeams(), 1, 1)
+	require.NoError(t, err)
+
+	tables, err := AssignTables(eightTeams(), 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, []TeamsPlayersMapping{tables}, rounds)
+}
+
+func TestEvaluateRounds(t *testing.T) {
+	rounds := []TeamsPlayersMapping{
+		{0: {{ID: 1, TeamID: 1}, {ID: 3, TeamID: 2}}, 1: {{ID: 2, TeamID: 1}, {ID: 4, TeamID: 2}}},
+		{0: {{ID: 1, TeamID: 1}, {ID: 3, TeamID: 2}}, 1: {{ID: 2, TeamID: 1}, {ID: 4, TeamID: 2}}},
+	}
+
+	assert.Equal(t, Quality{
+		PlayerRepeats:       2,
+		MaxPlayerEncounters: 2,
+		TeamRepeats:         3,
+		MaxTeamEncounters:   4,
+		Cost:                2*playerEncounterWeight + 6*teamEncounterWeight,
+	}, EvaluateRounds(rounds))
+}
+
+func TestAssignRoundsInvalidSetup(t *testing.T) {
+	_, _, err := AssignRounds(TeamSetup{Teams: map[int][]int{1: {1, 2}, 2: {3}}, TeamSize: 2, TableSize: 2}, 2, 1)
+
+	require.Error(t, err)
+}
+
+func TestAssignRoundsSpreadsByes(t *testing.T) {
+	teamSetup := eightTeamsOneCancelled(false, true)
+
+	rounds, _, err := AssignRounds(teamSetup, 4, 1)
+	require.NoError(t, err)
+
+	byes := map[int]int{}
+
+	for _, tables := range rounds {
+		for _, id := range Byes(teamSetup, tables) {
+			byes[id]++
+		}
+	}
+
+	assert.Len(t, byes, 12, "every round three different players sit out")
+
+	for id, count := range byes {
+		assert.Equal(t, 1, count, "player %d sits out more than once", id)
+	}
+}
+
+func TestAssignRound(t *testing.T) {
+	teamSetup := eightTeams()
+
+	rounds, _, err := AssignRounds(teamSetup, 3, 1)
+	require.NoError(t, err)
+
+	unchanged, _, err := AssignRounds(teamSetup, 3, 1)
+	require.NoError(t, err)
+
+	others := []TeamsPlayersMapping{rounds[0], rounds[2]}
+
+	tables, err := AssignRound(teamSetup, others, 7)
+	require.NoError(t, err)
+
+	for _, table := range tables {
+		teams := map[int]bool{}
+
+		for _, player := range table {
+			assert.False(t, teams[player.TeamID], "teammates must not share a table")
+			teams[player.TeamID] = true
+		}
+	}
+
+	assert.Equal(t, []TeamsPlayersMapping{unchanged[0], unchanged[2]}, others, "the other rounds stay unchanged")
+
+	independent, err := AssignTables(teamSetup, 7)
+	require.NoError(t, err)
+
+	assert.LessOrEqual(t,
+		EvaluateRounds([]TeamsPlayersMapping{rounds[0], rounds[2], tables}).Cost,
+		EvaluateRounds([]TeamsPlayersMapping{rounds[0], rounds[2], independent}).Cost,
+		"the redrawn round avoids encounters of the other rounds")
+}
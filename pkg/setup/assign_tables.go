@@ -12,8 +12,12 @@ type Player struct {
 	TeamID int
 }
 
-func IsAssignable(teams map[int][]int, teamSize, tableSize int) bool {
-	if teamSize%tableSize > 0 {
+// IsAssignable reports whether teams of exactly teamSize players fill all tables without two
+// teammates at the same table. A table layout replaces the tables of tableSize seats by tables with
+// the given numbers of seats. Teams of a single player, as in individual games, only need to fill
+// all tables.
+func IsAssignable(teams map[int][]int, teamSize, tableSize int, tableLayout ...int) bool {
+	if len(tableLayout) == 0 && teamSize > 1 && teamSize%tableSize > 0 {
 		return false
 	}
 
@@ -27,6 +31,19 @@ func IsAssignable(teams map[int][]int, teamSize, tableSize int) bool {
 		}
 	}
 	numberOfPlayers := len(teams) * teamSize
+
+	if len(tableLayout) > 0 {
+		if !validLayout(tableLayout) || sum(tableLayout) != numberOfPlayers {
+			return false
+		}
+
+		return fits(teamSizes(teams), tableLayout)
+	}
+
+	if numberOfPlayers%tableSize > 0 {
+		return false
+	}
+
 	numberOfTables := numberOfPlayers / tableSize
 
 	return numberOfTables >= teamSize
@@ -38,18 +55,224 @@ type TeamSetup struct {
 	Teams     map[int][]int
 	TeamSize  int
 	TableSize int
+	// ShortTables allows tables with one seat fewer than the table size when the players do not fill all tables.
+	ShortTables bool
+	// Byes allows players to sit out a round when the players do not fill all tables.
+	Byes bool
+	// TableLayout lists the seats of every table; if empty all tables have TableSize seats.
+	TableLayout []int
 }
 
+// Seats returns the number of physical seats of a table.
+func (t TeamSetup) Seats(table int) int {
+	if table < len(t.TableLayout) {
+		return t.TableLayout[table]
+	}
+
+	return t.TableSize
+}
+
+// Layout describes how the players of a setup are spread over the tables of one round.
+type Layout struct {
+	FullTables int
+	// ShortTables seat one player fewer than the table size.
+	ShortTables int
+	// Byes is the number of players sitting out the round.
+	Byes int
+	// Seats lists the seats of every table if the setup has a table layout.
+	Seats []int
+}
+
+func (l Layout) Tables() int {
+	return l.FullTables + l.ShortTables
+}
+
+// capacity returns the number of seats of a table; without a table layout the full tables come first.
+func (l Layout) capacity(table, tableSize int) int {
+	if l.Seats != nil {
+		return l.Seats[table]
+	}
+
+	if table < l.FullTables {
+		return tableSize
+	}
+
+	return tableSize - 1
+}
+
+func (l Layout) capacities(tableSize int) []int {
+	seats := make([]int, l.Tables())
+	for i := range seats {
+		seats[i] = l.capacity(i, tableSize)
+	}
+
+	return seats
+}
+
+// PlanTables returns the table layout of a setup. Without short tables and byes every team needs
+// exactly teamSize players that fill all tables, as checked by IsAssignable. Otherwise teams may be
+// smaller; the players left over are seated at short tables if allowed and sit out otherwise.
+// A setup is only assignable if the players can be seated without two teammates at the same table.
+func PlanTables(teamSetup TeamSetup) (Layout, bool) {
+	if !teamSetup.ShortTables && !teamSetup.Byes {
+		if !IsAssignable(teamSetup.Teams, teamSetup.TeamSize, teamSetup.TableSize, teamSetup.TableLayout...) {
+			return Layout{}, false
+		}
+
+		if len(teamSetup.TableLayout) > 0 {
+			return Layout{FullTables: len(teamSetup.TableLayout), Seats: slices.Clone(teamSetup.TableLayout)}, true
+		}
+
+		return Layout{FullTables: len(teamSetup.Teams) * teamSetup.TeamSize / teamSetup.TableSize}, true
+	}
+
+	if (len(teamSetup.TableLayout) == 0 && teamSetup.TableSize < 2) || !validLayout(teamSetup.TableLayout) {
+		return Layout{}, false
+	}
+
+	numberOfPlayers := 0
+
+	for teamID, members := range teamSetup.Teams {
+		if teamID < 1 || len(members) == 0 || len(members) > teamSetup.TeamSize {
+			return Layout{}, false
+		}
+
+		numberOfPlayers += len(members)
+	}
+
+	var (
+		layout Layout
+		ok     bool
+	)
+
+	if len(teamSetup.TableLayout) > 0 {
+		layout, ok = planCustomTables(teamSetup, numberOfPlayers)
+	} else {
+		layout, ok = planUniformTables(teamSetup, numberOfPlayers)
+	}
+
+	if !ok || layout.Tables() == 0 || !fits(teamSizes(teamSetup.Teams), layout.capacities(teamSetup.TableSize)) {
+		return Layout{}, false
+	}
+
+	return layout, true
+}
+
+func planUniformTables(teamSetup TeamSetup, numberOfPlayers int) (Layout, bool) {
+	numberOfTables := (numberOfPlayers + teamSetup.TableSize - 1) / teamSetup.TableSize
+	missingSeats := numberOfTables*teamSetup.TableSize - numberOfPlayers
+
+	switch {
+	case teamSetup.ShortTables && missingSeats <= numberOfTables:
+		return Layout{FullTables: numberOfTables - missingSeats, ShortTables: missingSeats}, true
+	case teamSetup.Byes:
+		return Layout{FullTables: numberOfPlayers / teamSetup.TableSize, Byes: numberOfPlayers % teamSetup.TableSize}, true
+	default:
+		return Layout{}, false
+	}
+}
+
+// planCustomTables fits the players to a table layout. Missing players leave one seat empty at
+// each of the largest tables; surplus players sit out.
+func planCustomTables(teamSetup TeamSetup, numberOfPlayers int) (Layout, bool) {
+	seats := slices.Clone(teamSetup.TableLayout)
+	numberOfTables := len(seats)
+	totalSeats := sum(seats)
+
+	switch {
+	case numberOfPlayers == totalSeats:
+		return Layout{FullTables: numberOfTables, Seats: seats}, true
+	case numberOfPlayers < totalSeats && teamSetup.ShortTables && totalSeats-numberOfPlayers <= numberOfTables:
+		missingSeats := totalSeats - numberOfPlayers
+
+		largest := make([]int, numberOfTables)
+		for i := range largest {
+			largest[i] = i
+		}
+
+		slices.SortStableFunc(largest, func(a, b int) int { return seats[b] - seats[a] })
+
+		for _, table := range largest[:missingSeats] {
+			seats[table]--
+		}
+
+		return Layout{FullTables: numberOfTables - missingSeats, ShortTables: missingSeats, Seats: seats}, true
+	case numberOfPlayers > totalSeats && teamSetup.Byes:
+		return Layout{FullTables: numberOfTables, Byes: numberOfPlayers - totalSeats, Seats: seats}, true
+	default:
+		return Layout{}, false
+	}
+}
+
+func validLayout(tableLayout []int) bool {
+	for _, seats := range tableLayout {
+		if seats < 2 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sum(values []int) int {
+	total := 0
+	for _, value := range values {
+		total += value
+	}
+
+	return total
+}
+
+func teamSizes(teams map[int][]int) []int {
+	sizes := make([]int, 0, len(teams))
+	for _, members := range teams {
+		sizes = append(sizes, len(members))
+	}
+
+	return sizes
+}
+
+// fits reports whether teams of the given sizes can fill every seat of the tables without two
+// teammates at the same table (Gale-Ryser): the k largest tables must never need more players than
+// the teams provide with at most one player per table each.
+func fits(teamSizes, seats []int) bool {
+	sorted := slices.Sorted(slices.Values(seats))
+	slices.Reverse(sorted)
+
+	needed := 0
+
+	for k, tableSeats := range sorted {
+		needed += tableSeats
+
+		available := 0
+		for _, size := range teamSizes {
+			available += min(size, k+1)
+		}
+
+		if needed > available {
+			return false
+		}
+	}
+
+	return true
+}
+
+// maxAttempts bounds the shuffles AssignTables tries before it seats the largest teams first.
+const maxAttempts = 100
+
+// AssignTables shuffles the players with the given seed and seats them greedily so that no two
+// teammates share a table. Players left over by the layout sit out the round and are missing from
+// the returned tables.
 func AssignTables(teamSetup TeamSetup, seed int64) (TeamsPlayersMapping, error) {
-	if !IsAssignable(teamSetup.Teams, teamSetup.TeamSize, teamSetup.TableSize) {
+	layout, ok := PlanTables(teamSetup)
+	if !ok {
 		return nil, fmt.Errorf("invalid setup: teams=%d teamSize=%d tableSize=%d", len(teamSetup.Teams), teamSetup.TeamSize, teamSetup.TableSize)
 	}
 
-	for {
+	for attempt := 0; ; attempt++ {
 		numberOfTeams := len(teamSetup.Teams)
-		numberOfPlayers := teamSetup.TeamSize * numberOfTeams
 
-		playersToAssign := make([]Player, 0, numberOfPlayers)
+		playersToAssign := make([]Player, 0, teamSetup.TeamSize*numberOfTeams)
 
 		teamIDs := make([]int, 0, numberOfTeams)
 
@@ -60,7 +283,7 @@ func AssignTables(teamSetup TeamSetup, seed int64) (TeamsPlayersMapping, error)
 		sort.Ints(teamIDs)
 
 		for _, teamID := range teamIDs {
-			memberIDs := teamSetup.Teams[teamID]
+			memberIDs := slices.Sorted(slices.Values(teamSetup.Teams[teamID]))
 			teamMembers := make([]Player, 0, len(memberIDs))
 
 			for _, id := range memberIDs {
@@ -75,20 +298,31 @@ func AssignTables(teamSetup TeamSetup, seed int64) (TeamsPlayersMapping, error)
 
 		rnd := rand.New(rand.NewSource(seed)) //nolint:gosec // G404: deterministic seeded shuffle for table assignment, not security-sensitive
 
-		rnd.Shuffle(numberOfPlayers, func(i, j int) {
+		rnd.Shuffle(len(playersToAssign), func(i, j int) {
 			playersToAssign[i], playersToAssign[j] = playersToAssign[j], playersToAssign[i]
 		})
 
-		numberOfTables := numberOfPlayers / teamSetup.TableSize
+		if attempt == maxAttempts {
+			return seatLargestTeamsFirst(playersToAssign, layout.capacities(teamSetup.TableSize)), nil
+		}
+
+		playersToAssign = playersToAssign[:len(playersToAssign)-layout.Byes]
+
+		numberOfTables := layout.Tables()
+		largestTable := slices.Max(layout.capacities(teamSetup.TableSize))
 
 		tables := make(map[int][]Player, numberOfTables)
 		for i := range numberOfTables {
-			tables[i] = make([]Player, 0, teamSetup.TableSize)
+			tables[i] = make([]Player, 0, largestTable)
 		}
 
-		for range teamSetup.TableSize {
+		for range largestTable {
 			for tableID := range numberOfTables {
 				assignedToTable := tables[tableID]
+				if len(assignedToTable) == layout.capacity(tableID, teamSetup.TableSize) {
+					continue
+				}
+
 				for i, playerToAssign := range playersToAssign {
 					containsSameTeamID := slices.ContainsFunc(assignedToTable, func(p Player) bool {
 						return p.TeamID == playerToAssign.TeamID
@@ -110,3 +344,107 @@ func AssignTables(teamSetup TeamSetup, seed int64) (TeamsPlayersMapping, error)
 		seed++
 	}
 }
+
+// seatLargestTeamsFirst fills the tables from the largest one down, each with one player of every
+// team that has the most players left, so that no team ends up with more players than tables to
+// seat them (Ryser's construction). Ties go to the team appearing first; players left over sit out.
+func seatLargestTeamsFirst(players []Player, seats []int) TeamsPlayersMapping {
+	var teamOrder []int
+
+	byTeam := map[int][]Player{}
+
+	for _, player := range players {
+		if _, ok := byTeam[player.TeamID]; !ok {
+			teamOrder = append(teamOrder, player.TeamID)
+		}
+
+		byTeam[player.TeamID] = append(byTeam[player.TeamID], player)
+	}
+
+	tableOrder := make([]int, len(seats))
+	for i := range tableOrder {
+		tableOrder[i] = i
+	}
+
+	slices.SortStableFunc(tableOrder, func(a, b int) int { return seats[b] - seats[a] })
+
+	tables := make(TeamsPlayersMapping, len(seats))
+
+	for _, table := range tableOrder {
+		teams := slices.Clone(teamOrder)
+		slices.SortStableFunc(teams, func(a, b int) int { return len(byTeam[b]) - len(byTeam[a]) })
+
+		tables[table] = make([]Player, 0, seats[table])
+
+		for _, teamID := range teams[:min(seats[table], len(teams))] {
+			if len(byTeam[teamID]) == 0 {
+				break
+			}
+
+			tables[table] = append(tables[table], byTeam[teamID][0])
+			byTeam[teamID] = byTeam[teamID][1:]
+		}
+	}
+
+	return tables
+}
+
+// Byes returns the IDs of the players of a setup who are not seated at any of the given tables.
+func Byes(teamSetup TeamSetup, tables TeamsPlayersMapping) []int {
+	players := sittingOut(teamSetup, tables)
+
+	byes := make([]int, len(players))
+	for i, player := range players {
+		byes[i] = player.ID
+	}
+
+	return byes
+}
+
+func sittingOut(teamSetup TeamSetup, tables TeamsPlayersMapping) []Player {
+	seated := map[int]bool{}
+
+	for _, table := range tables {
+		for _, player := range table {
+			seated[player.ID] = true
+		}
+	}
+
+	players := []Player{}
+
+	for teamID, members := range teamSetup.Teams {
+		for _, id := range members {
+			if !seated[id] {
+				players = append(players, Player{ID: id, TeamID: teamID})
+			}
+		}
+	}
+
+	slices.SortFunc(players, func(a, b Player) int { return a.ID - b.ID })
+
+	return players
+}
+
+// AssignSeats orders the players of every table by seat so that the starting player rotates over the
+// rounds: the first seat goes to the player of the table who has started the fewest rounds so far,
+// the first one in drawn order on a tie, and the other players follow in drawn order around the table.
+// starts counts the rounds every player has started and is updated with the new starters.
+func AssignSeats(tables TeamsPlayersMapping, starts map[int]int) {
+	for tableNumber := range len(tables) {
+		table := tables[tableNumber]
+		if len(table) == 0 {
+			continue
+		}
+
+		starter := 0
+
+		for i, player := range table {
+			if starts[player.ID] < starts[table[starter].ID] {
+				starter = i
+			}
+		}
+
+		starts[table[starter].ID]++
+		tables[tableNumber] = append(slices.Clone(table[starter:]), table[:starter]...)
+	}
+}
@@ -174,3 +174,225 @@ func TestAssignTablesMoreTablesThanTeams(t *testing.T) {
 
 	assert.Len(t, assignedPlayers, 32, "all players must be assigned exactly once")
 }
+
+func TestAssignTablesIgnoresMemberOrder(t *testing.T) {
+	sorted := map[int][]int{1: {1, 2}, 2: {3, 4}, 3: {5, 6}, 4: {7, 8}}
+	shuffled := map[int][]int{1: {2, 1}, 2: {4, 3}, 3: {5, 6}, 4: {8, 7}}
+
+	want, err := AssignTables(TeamSetup{Teams: sorted, TeamSize: 2, TableSize: 2}, 7)
+	require.NoError(t, err)
+
+	got, err := AssignTables(TeamSetup{Teams: shuffled, TeamSize: 2, TableSize: 2}, 7)
+	require.NoError(t, err)
+
+	assert.Equal(t, want, got, "the same seed draws the same tables regardless of the order players were loaded in")
+}
+
+// eightTeamsOneCancelled is a setup of eight teams of four where one team lost a player.
+func eightTeamsOneCancelled(shortTables, byes bool) TeamSetup {
+	teamSetup := eightTeams()
+	teamSetup.Teams[8] = []int{29, 30, 31}
+	teamSetup.ShortTables = shortTables
+	teamSetup.Byes = byes
+
+	return teamSetup
+}
+
+func TestPlanTables(t *testing.T) {
+	tests := []struct {
+		name      string
+		teamSetup TeamSetup
+		expected  Layout
+		ok        bool
+	}{
+		{name: "full tables", teamSetup: eightTeams(), expected: Layout{FullTables: 8}, ok: true},
+		{name: "cancelled player without short tables or byes", teamSetup: eightTeamsOneCancelled(false, false), ok: false},
+		{name: "cancelled player with short tables", teamSetup: eightTeamsOneCancelled(true, false), expected: Layout{FullTables: 7, ShortTables: 1}, ok: true},
+		{name: "cancelled player with byes", teamSetup: eightTeamsOneCancelled(false, true), expected: Layout{FullTables: 7, Byes: 3}, ok: true},
+		{name: "short tables are preferred over byes", teamSetup: eightTeamsOneCancelled(true, true), expected: Layout{FullTables: 7, ShortTables: 1}, ok: true},
+		{
+			name:      "too many missing seats for short tables falls back to byes",
+			teamSetup: TeamSetup{Teams: map[int][]int{1: {1, 2}, 2: {3, 4}, 3: {5, 6}, 4: {7, 8}, 5: {9, 10}, 6: {11}}, TeamSize: 2, TableSize: 5, ShortTables: true, Byes: true},
+			expected:  Layout{FullTables: 2, Byes: 1},
+			ok:        true,
+		},
+		{
+			name:      "too many missing seats for short tables",
+			teamSetup: TeamSetup{Teams: map[int][]int{1: {1, 2}, 2: {3, 4}, 3: {5, 6}, 4: {7, 8}, 5: {9, 10}, 6: {11}}, TeamSize: 2, TableSize: 5, ShortTables: true},
+			ok:        false,
+		},
+		{
+			name:      "team larger than the number of tables",
+			teamSetup: TeamSetup{Teams: map[int][]int{1: {1, 2}, 2: {3, 4}, 3: {5}}, TeamSize: 2, TableSize: 4, Byes: true},
+			ok:        false,
+		},
+		{
+			name:      "table layout",
+			teamSetup: withTableLayout(eightTeams(), 5, 5, 5, 5, 4, 4, 4),
+			expected:  Layout{FullTables: 7, Seats: []int{5, 5, 5, 5, 4, 4, 4}},
+			ok:        true,
+		},
+		{
+			name:      "table layout with more seats than teams",
+			teamSetup: withTableLayout(eightTeams(), 9, 9, 9, 5),
+			ok:        false,
+		},
+		{
+			name:      "table layout with short tables leaves a seat empty at the largest table",
+			teamSetup: withTableLayout(eightTeamsOneCancelled(true, false), 4, 5, 5, 5, 5, 4, 4),
+			expected:  Layout{FullTables: 6, ShortTables: 1, Seats: []int{4, 4, 5, 5, 5, 4, 4}},
+			ok:        true,
+		},
+		{
+			name:      "table layout with byes",
+			teamSetup: withTableLayout(eightTeamsOneCancelled(false, true), 6, 6, 6, 6, 6),
+			expected:  Layout{FullTables: 5, Byes: 1, Seats: []int{6, 6, 6, 6, 6}},
+			ok:        true,
+		},
+		{
+			name:      "table layout with a single seat",
+			teamSetup: withTableLayout(eightTeamsOneCancelled(false, true), 1, 6, 6, 6, 6, 6),
+			ok:        false,
+		},
+		{
+			name:      "team larger than the team size",
+			teamSetup: TeamSetup{Teams: map[int][]int{1: {1, 2, 3}, 2: {4, 5}}, TeamSize: 2, TableSize: 2, Byes: true},
+			ok:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := PlanTables(tt.teamSetup)
+
+			assert.Equal(t, tt.ok, ok)
+
+			if tt.ok {
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestAssignTablesShortTables(t *testing.T) {
+	got, err := AssignTables(eightTeamsOneCancelled(true, false), 1)
+	require.NoError(t, err)
+	require.Len(t, got, 8)
+
+	sizes := map[int]int{}
+	seated := map[int]bool{}
+
+	for _, table := range got {
+		sizes[len(table)]++
+
+		teams := map[int]bool{}
+
+		for _, player := range table {
+			assert.False(t, teams[player.TeamID], "teammates must not share a table")
+			teams[player.TeamID] = true
+			seated[player.ID] = true
+		}
+	}
+
+	assert.Equal(t, map[int]int{4: 7, 3: 1}, sizes, "one table seats one player fewer")
+	assert.Len(t, seated, 31)
+	assert.Empty(t, Byes(eightTeamsOneCancelled(true, false), got))
+}
+
+func TestAssignTablesByes(t *testing.T) {
+	teamSetup := eightTeamsOneCancelled(false, true)
+
+	got, err := AssignTables(teamSetup, 1)
+	require.NoError(t, err)
+	require.Len(t, got, 7)
+
+	for _, table := range got {
+		assert.Len(t, table, 4)
+	}
+
+	assert.Len(t, Byes(teamSetup, got), 3)
+}
+
+func withTableLayout(teamSetup TeamSetup, tableLayout ...int) TeamSetup {
+	teamSetup.TableLayout = tableLayout
+
+	return teamSetup
+}
+
+func TestIsAssignableTableLayout(t *testing.T) {
+	teams := map[int][]int{1: {1, 2}, 2: {3, 4}, 3: {5, 6}, 4: {7, 8}}
+
+	assert.True(t, IsAssignable(teams, 2, 4, 4, 4))
+	assert.True(t, IsAssignable(teams, 2, 4, 3, 3, 2), "tables of different sizes")
+	assert.False(t, IsAssignable(teams, 2, 4, 5, 3), "a table with more seats than teams")
+	assert.False(t, IsAssignable(teams, 2, 4, 4, 3), "the layout must seat every player")
+}
+
+func TestIsAssignableSinglePlayerTeams(t *testing.T) {
+	players := map[int][]int{1: {1}, 2: {2}, 3: {3}, 4: {4}, 5: {5}, 6: {6}, 7: {7}, 8: {8}}
+
+	assert.True(t, IsAssignable(players, 1, 4))
+	assert.False(t, IsAssignable(players, 1, 3), "the players must fill all tables")
+	assert.False(t, IsAssignable(map[int][]int{1: {1}, 2: {2}}, 1, 4), "too few players for a table")
+}
+
+func TestAssignTablesTableLayout(t *testing.T) {
+	tableLayout := []int{5, 5, 5, 5, 4, 4, 4}
+
+	got, err := AssignTables(withTableLayout(eightTeams(), tableLayout...), 1)
+	require.NoError(t, err)
+	require.Len(t, got, 7)
+
+	seated := map[int]bool{}
+
+	for tableID, table := range got {
+		assert.Len(t, table, tableLayout[tableID])
+
+		teams := map[int]bool{}
+
+		for _, player := range table {
+			assert.False(t, teams[player.TeamID], "teammates must not share a table")
+			teams[player.TeamID] = true
+			seated[player.ID] = true
+		}
+	}
+
+	assert.Len(t, seated, 32)
+}
+
+func TestSeatLargestTeamsFirst(t *testing.T) {
+	players := []Player{{ID: 1, TeamID: 1}, {ID: 3, TeamID: 2}, {ID: 2, TeamID: 1}, {ID: 5, TeamID: 3}, {ID: 4, TeamID: 2}}
+
+	assert.Equal(t, TeamsPlayersMapping{
+		0: {{ID: 1, TeamID: 1}, {ID: 3, TeamID: 2}},
+		1: {{ID: 2, TeamID: 1}, {ID: 4, TeamID: 2}},
+		2: {{ID: 5, TeamID: 3}},
+	}, seatLargestTeamsFirst(players, []int{2, 2, 1}), "the teams with the most players left are seated first")
+
+	assert.Equal(t, TeamsPlayersMapping{
+		0: {{ID: 2, TeamID: 1}},
+		1: {{ID: 1, TeamID: 1}, {ID: 3, TeamID: 2}, {ID: 5, TeamID: 3}},
+	}, seatLargestTeamsFirst(players, []int{1, 3}), "the largest table is filled first and player 4 sits out")
+}
+
+func TestAssignSeats(t *testing.T) {
+	starts := map[int]int{}
+
+	first := TeamsPlayersMapping{
+		0: {{ID: 1, TeamID: 1}, {ID: 3, TeamID: 2}, {ID: 5, TeamID: 3}},
+		1: {{ID: 2, TeamID: 1}, {ID: 4, TeamID: 2}, {ID: 6, TeamID: 3}},
+	}
+	AssignSeats(first, starts)
+
+	assert.Equal(t, []Player{{ID: 1, TeamID: 1}, {ID: 3, TeamID: 2}, {ID: 5, TeamID: 3}}, first[0], "the first drawn player starts on a tie")
+
+	second := TeamsPlayersMapping{
+		0: {{ID: 1, TeamID: 1}, {ID: 4, TeamID: 2}, {ID: 5, TeamID: 3}},
+		1: {{ID: 2, TeamID: 1}, {ID: 3, TeamID: 2}, {ID: 6, TeamID: 3}},
+	}
+	AssignSeats(second, starts)
+
+	assert.Equal(t, []Player{{ID: 4, TeamID: 2}, {ID: 5, TeamID: 3}, {ID: 1, TeamID: 1}}, second[0], "the order around the table is kept")
+	assert.Equal(t, []Player{{ID: 3, TeamID: 2}, {ID: 6, TeamID: 3}, {ID: 2, TeamID: 1}}, second[1])
+	assert.Equal(t, map[int]int{1: 1, 2: 1, 3: 1, 4: 1}, starts)
+}
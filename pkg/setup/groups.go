@@ -0,0 +1,42 @@
+package setup
+
+// SnakeGroups deals the given IDs, best first, over the given number of groups in a snake order, so
+// that every group gets a similar mix of strong and weaker entries: with two groups the first one gets
+// entries 1, 4, 5 and 8, the second one entries 2, 3, 6 and 7.
+func SnakeGroups(ids []int, groups int) [][]int {
+	if len(ids) == 0 || groups < 1 {
+		return nil
+	}
+
+	dealt := make([][]int, groups)
+	for i := range dealt {
+		dealt[i] = make([]int, 0, (len(ids)+groups-1)/groups)
+	}
+
+	for i, id := range ids {
+		group := i % groups
+		if (i/groups)%2 == 1 {
+			group = groups - 1 - group
+		}
+
+		dealt[group] = append(dealt[group], id)
+	}
+
+	return dealt
+}
+
+// MergeTables numbers the tables of groups drawn separately for the same round consecutively, the
+// tables of the first group first.
+func MergeTables(groups []TeamsPlayersMapping) TeamsPlayersMapping {
+	merged := TeamsPlayersMapping{}
+
+	for _, tables := range groups {
+		offset := len(merged)
+
+		for tableNumber := range len(tables) {
+			merged[offset+tableNumber] = tables[tableNumber]
+		}
+	}
+
+	return merged
+}
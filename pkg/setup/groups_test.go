@@ -0,0 +1,60 @@
+package setup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnakeGroups(t *testing.T) {
+	tests := []struct {
+		name     string
+		ids      []int
+		groups   int
+		expected [][]int
+	}{
+		{
+			name:     "single group",
+			ids:      []int{4, 2, 3},
+			groups:   1,
+			expected: [][]int{{4, 2, 3}},
+		},
+		{
+			name:     "entries are dealt in snake order",
+			ids:      []int{1, 2, 3, 4, 5, 6, 7, 8, 9},
+			groups:   3,
+			expected: [][]int{{1, 6, 7}, {2, 5, 8}, {3, 4, 9}},
+		},
+		{
+			name:     "more groups than entries",
+			ids:      []int{1},
+			groups:   2,
+			expected: [][]int{{1}, {}},
+		},
+		{
+			name:     "no entries",
+			ids:      nil,
+			groups:   2,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, SnakeGroups(tc.ids, tc.groups))
+		})
+	}
+}
+
+func TestMergeTables(t *testing.T) {
+	groups := []TeamsPlayersMapping{
+		{0: {{ID: 1, TeamID: 1}, {ID: 2, TeamID: 2}}, 1: {{ID: 3, TeamID: 3}, {ID: 4, TeamID: 4}}},
+		{0: {{ID: 5, TeamID: 5}, {ID: 6, TeamID: 6}}},
+	}
+
+	assert.Equal(t, TeamsPlayersMapping{
+		0: {{ID: 1, TeamID: 1}, {ID: 2, TeamID: 2}},
+		1: {{ID: 3, TeamID: 3}, {ID: 4, TeamID: 4}},
+		2: {{ID: 5, TeamID: 5}, {ID: 6, TeamID: 6}},
+	}, MergeTables(groups))
+}
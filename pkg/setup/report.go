@@ -0,0 +1,186 @@
+package setup
+
+import (
+	"slices"
+	"strings"
+)
+
+type ViolationType string
+
+const (
+	ViolationTeammatesAtTable ViolationType = "teammates_at_table"
+	ViolationSeatedTwice      ViolationType = "seated_twice"
+)
+
+// RoundTables are the tables of a persisted round keyed by table number.
+type RoundTables struct {
+	RoundNumber int
+	Tables      TeamsPlayersMapping
+}
+
+// EncounterMatrix counts how often two participants met. Counts[i][j] belongs to IDs[i] and IDs[j].
+type EncounterMatrix struct {
+	IDs    []int
+	Counts [][]int
+}
+
+// TableSpread lists the table number of a player per round; 0 marks a round the player was not seated in.
+type TableSpread struct {
+	PlayerID       int
+	Tables         []int
+	DistinctTables int
+}
+
+type Violation struct {
+	Type        ViolationType
+	RoundNumber int
+	TableNumber int
+	PlayerIDs   []int
+}
+
+type Report struct {
+	PlayerEncounters    EncounterMatrix
+	TeamEncounters      EncounterMatrix
+	MaxPlayerEncounters int
+	MaxTeamEncounters   int
+	TableSpread         []TableSpread
+	Violations          []Violation
+}
+
+// NewReport analyses the seating of the given rounds. Teammates sitting together are reported as a
+// violation and not counted as a team encounter.
+func NewReport(teams map[int][]int, rounds []RoundTables) Report {
+	rounds = slices.Clone(rounds)
+	slices.SortFunc(rounds, func(a, b RoundTables) int { return a.RoundNumber - b.RoundNumber })
+
+	teamIDs := make([]int, 0, len(teams))
+	playerIDs := []int{}
+
+	for teamID, members := range teams {
+		teamIDs = append(teamIDs, teamID)
+		playerIDs = append(playerIDs, members...)
+	}
+
+	slices.Sort(teamIDs)
+	slices.Sort(playerIDs)
+
+	report := Report{
+		PlayerEncounters: newEncounterMatrix(playerIDs),
+		TeamEncounters:   newEncounterMatrix(teamIDs),
+		Violations:       []Violation{},
+	}
+
+	spread := make(map[int][]int, len(playerIDs))
+	for _, playerID := range playerIDs {
+		spread[playerID] = make([]int, len(rounds))
+	}
+
+	for roundIndex, round := range rounds {
+		seated := map[int]bool{}
+
+		for _, tableNumber := range sortedTableNumbers(round.Tables) {
+			table := round.Tables[tableNumber]
+
+			for i, player := range table {
+				if seated[player.ID] {
+					report.Violations = append(report.Violations, Violation{
+						Type: ViolationSeatedTwice, RoundNumber: round.RoundNumber, TableNumber: tableNumber, PlayerIDs: []int{player.ID},
+					})
+				} else if tables, ok := spread[player.ID]; ok {
+					tables[roundIndex] = tableNumber
+				}
+
+				seated[player.ID] = true
+
+				for _, other := range table[i+1:] {
+					report.PlayerEncounters.add(player.ID, other.ID)
+
+					if player.TeamID == other.TeamID {
+						report.Violations = append(report.Violations, Violation{
+							Type: ViolationTeammatesAtTable, RoundNumber: round.RoundNumber, TableNumber: tableNumber, PlayerIDs: []int{min(player.ID, other.ID), max(player.ID, other.ID)},
+						})
+
+						continue
+					}
+
+					report.TeamEncounters.add(player.TeamID, other.TeamID)
+				}
+			}
+		}
+	}
+
+	slices.SortStableFunc(report.Violations, func(a, b Violation) int {
+		if a.RoundNumber != b.RoundNumber {
+			return a.RoundNumber - b.RoundNumber
+		}
+
+		if a.TableNumber != b.TableNumber {
+			return a.TableNumber - b.TableNumber
+		}
+
+		return strings.Compare(string(a.Type), string(b.Type))
+	})
+
+	report.MaxPlayerEncounters = report.PlayerEncounters.max()
+	report.MaxTeamEncounters = report.TeamEncounters.max()
+
+	report.TableSpread = make([]TableSpread, 0, len(playerIDs))
+
+	for _, playerID := range playerIDs {
+		distinct := map[int]bool{}
+
+		for _, tableNumber := range spread[playerID] {
+			if tableNumber > 0 {
+				distinct[tableNumber] = true
+			}
+		}
+
+		report.TableSpread = append(report.TableSpread, TableSpread{PlayerID: playerID, Tables: spread[playerID], DistinctTables: len(distinct)})
+	}
+
+	return report
+}
+
+func newEncounterMatrix(ids []int) EncounterMatrix {
+	counts := make([][]int, len(ids))
+	for i := range counts {
+		counts[i] = make([]int, len(ids))
+	}
+
+	return EncounterMatrix{IDs: ids, Counts: counts}
+}
+
+func (m EncounterMatrix) add(a, b int) {
+	i, okA := slices.BinarySearch(m.IDs, a)
+	j, okB := slices.BinarySearch(m.IDs, b)
+
+	if !okA || !okB || i == j {
+		return
+	}
+
+	m.Counts[i][j]++
+	m.Counts[j][i]++
+}
+
+func (m EncounterMatrix) max() int {
+	highest := 0
+
+	for _, row := range m.Counts {
+		for _, count := range row {
+			highest = max(highest, count)
+		}
+	}
+
+	return highest
+}
+
+func sortedTableNumbers(tables TeamsPlayersMapping) []int {
+	tableNumbers := make([]int, 0, len(tables))
+	for tableNumber := range tables {
+		tableNumbers = append(tableNumbers, tableNumber)
+	}
+
+	slices.Sort(tableNumbers)
+
+	return tableNumbers
+}
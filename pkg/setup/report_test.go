@@ -0,0 +1,69 @@
+package setup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReport(t *testing.T) {
+	teams := map[int][]int{1: {1, 2}, 2: {3, 4}}
+
+	rounds := []RoundTables{
+		{RoundNumber: 2, Tables: TeamsPlayersMapping{
+			1: {{ID: 1, TeamID: 1}, {ID: 3, TeamID: 2}},
+			2: {{ID: 2, TeamID: 1}, {ID: 4, TeamID: 2}},
+		}},
+		{RoundNumber: 1, Tables: TeamsPlayersMapping{
+			1: {{ID: 1, TeamID: 1}, {ID: 3, TeamID: 2}},
+			2: {{ID: 4, TeamID: 2}, {ID: 2, TeamID: 1}},
+		}},
+	}
+
+	got := NewReport(teams, rounds)
+
+	assert.Equal(t, EncounterMatrix{
+		IDs: []int{1, 2, 3, 4},
+		Counts: [][]int{
+			{0, 0, 2, 0},
+			{0, 0, 0, 2},
+			{2, 0, 0, 0},
+			{0, 2, 0, 0},
+		},
+	}, got.PlayerEncounters)
+	assert.Equal(t, EncounterMatrix{IDs: []int{1, 2}, Counts: [][]int{{0, 4}, {4, 0}}}, got.TeamEncounters)
+	assert.Equal(t, 2, got.MaxPlayerEncounters)
+	assert.Equal(t, 4, got.MaxTeamEncounters)
+	assert.Equal(t, []TableSpread{
+		{PlayerID: 1, Tables: []int{1, 1}, DistinctTables: 1},
+		{PlayerID: 2, Tables: []int{2, 2}, DistinctTables: 1},
+		{PlayerID: 3, Tables: []int{1, 1}, DistinctTables: 1},
+		{PlayerID: 4, Tables: []int{2, 2}, DistinctTables: 1},
+	}, got.TableSpread, "spread is ordered by round number")
+	assert.Empty(t, got.Violations)
+}
+
+func TestNewReportViolations(t *testing.T) {
+	teams := map[int][]int{1: {1, 2}, 2: {3, 4}}
+
+	rounds := []RoundTables{
+		{RoundNumber: 1, Tables: TeamsPlayersMapping{
+			1: {{ID: 1, TeamID: 1}, {ID: 2, TeamID: 1}},
+			2: {{ID: 3, TeamID: 2}, {ID: 1, TeamID: 1}},
+		}},
+	}
+
+	got := NewReport(teams, rounds)
+
+	assert.Equal(t, []Violation{
+		{Type: ViolationTeammatesAtTable, RoundNumber: 1, TableNumber: 1, PlayerIDs: []int{1, 2}},
+		{Type: ViolationSeatedTwice, RoundNumber: 1, TableNumber: 2, PlayerIDs: []int{1}},
+	}, got.Violations)
+	assert.Equal(t, []TableSpread{
+		{PlayerID: 1, Tables: []int{1}, DistinctTables: 1},
+		{PlayerID: 2, Tables: []int{1}, DistinctTables: 1},
+		{PlayerID: 3, Tables: []int{2}, DistinctTables: 1},
+		{PlayerID: 4, Tables: []int{0}, DistinctTables: 0},
+	}, got.TableSpread, "player 4 was not seated")
+	assert.Equal(t, 1, got.MaxTeamEncounters, "teammates sitting together are not a team encounter")
+}
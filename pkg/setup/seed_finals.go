@@ -0,0 +1,13 @@
+package setup
+
+// SeedFinalTables spreads the finalists, best seed first, over as few final tables as the table size
+// allows. Seeds are dealt in a snake order, so that every table gets a similar mix of strong and
+// weaker finalists: with two tables the first one seats seeds 1, 4, 5 and 8, the second one seeds 2,
+// 3, 6 and 7. Teammates may share a final table.
+func SeedFinalTables(finalists []int, tableSize int) [][]int {
+	if len(finalists) == 0 || tableSize < 1 {
+		return nil
+	}
+
+	return SnakeGroups(finalists, (len(finalists)+tableSize-1)/tableSize)
+}
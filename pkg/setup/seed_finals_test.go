@@ -0,0 +1,47 @@
+package setup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeedFinalTables(t *testing.T) {
+	tests := []struct {
+		name      string
+		finalists []int
+		tableSize int
+		expected  [][]int
+	}{
+		{
+			name:      "single final table",
+			finalists: []int{7, 3, 5, 1},
+			tableSize: 4,
+			expected:  [][]int{{7, 3, 5, 1}},
+		},
+		{
+			name:      "seeds are dealt in snake order",
+			finalists: []int{1, 2, 3, 4, 5, 6, 7, 8},
+			tableSize: 4,
+			expected:  [][]int{{1, 4, 5, 8}, {2, 3, 6, 7}},
+		},
+		{
+			name:      "last table stays short",
+			finalists: []int{1, 2, 3, 4, 5},
+			tableSize: 4,
+			expected:  [][]int{{1, 4, 5}, {2, 3}},
+		},
+		{
+			name:      "no finalists",
+			finalists: nil,
+			tableSize: 4,
+			expected:  nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, SeedFinalTables(tc.finalists, tc.tableSize))
+		})
+	}
+}
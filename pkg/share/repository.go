@@ -0,0 +1,55 @@
+package share
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+type SharesRepository struct {
+	db *gorm.DB
+}
+
+func NewSharesRepository(db *gorm.DB) *SharesRepository {
+	return &SharesRepository{db}
+}
+
+func (r *SharesRepository) FindByGameID(ctx context.Context, gameID int) (entity.GameShare, error) {
+	var share entity.GameShare
+
+	if err := r.db.WithContext(ctx).Where("game_id = ?", gameID).First(&share).Error; err != nil {
+		return entity.GameShare{}, err
+	}
+
+	return share, nil
+}
+
+func (r *SharesRepository) FindByToken(ctx context.Context, token string) (entity.GameShare, error) {
+	var share entity.GameShare
+
+	if err := r.db.WithContext(ctx).Where("token = ?", token).First(&share).Error; err != nil {
+		return entity.GameShare{}, err
+	}
+
+	return share, nil
+}
+
+// ReplaceShare stores the share of a game, replacing the previous share of the game if there is one.
+func (r *SharesRepository) ReplaceShare(ctx context.Context, share *entity.GameShare) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("game_id = ?", share.GameID).Delete(&entity.GameShare{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(share).Error
+	})
+}
+
+// DeleteShare deletes the share of a game and reports whether the game was shared.
+func (r *SharesRepository) DeleteShare(ctx context.Context, gameID int) (bool, error) {
+	result := r.db.WithContext(ctx).Where("game_id = ?", gameID).Delete(&entity.GameShare{})
+
+	return result.RowsAffected > 0, result.Error
+}
@@ -0,0 +1,164 @@
+package share
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+
+	"github.com/henok321/knobel-manager-service/gen/api"
+	"github.com/henok321/knobel-manager-service/pkg/apperror"
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+	"github.com/henok321/knobel-manager-service/pkg/game"
+	"github.com/henok321/knobel-manager-service/pkg/standings"
+)
+
+type SharesService struct {
+	repo         *SharesRepository
+	gamesRepo    *game.GamesRepository
+	gamesService *game.GamesService
+}
+
+func NewSharesService(repo *SharesRepository, gamesRepo *game.GamesRepository, gamesService *game.GamesService) *SharesService {
+	return &SharesService{repo: repo, gamesRepo: gamesRepo, gamesService: gamesService}
+}
+
+// Share returns the share of a game.
+func (s *SharesService) Share(ctx context.Context, gameID int, sub string) (entity.GameShare, error) {
+	if _, err := s.gamesService.FindByID(ctx, gameID, sub); err != nil {
+		return entity.GameShare{}, err
+	}
+
+	share, err := s.repo.FindByGameID(ctx, gameID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return entity.GameShare{}, apperror.ErrShareNotFound
+	}
+
+	return share, err
+}
+
+// CreateShare shares a game under a new random token, which replaces the token of an earlier share.
+func (s *SharesService) CreateShare(ctx context.Context, gameID int, sub string, request api.ShareRequest) (entity.GameShare, error) {
+	if _, err := s.gamesService.FindByID(ctx, gameID, sub); err != nil {
+		return entity.GameShare{}, err
+	}
+
+	share := entity.GameShare{
+		GameID:          gameID,
+		Token:           rand.Text(),
+		AbbreviateNames: request.AbbreviateNames != nil && *request.AbbreviateNames,
+	}
+
+	if err := s.repo.ReplaceShare(ctx, &share); err != nil {
+		return entity.GameShare{}, err
+	}
+
+	return share, nil
+}
+
+// RevokeShare deletes the share of a game; its token stops working immediately.
+func (s *SharesService) RevokeShare(ctx context.Context, gameID int, sub string) error {
+	if _, err := s.gamesService.FindByID(ctx, gameID, sub); err != nil {
+		return err
+	}
+
+	deleted, err := s.repo.DeleteShare(ctx, gameID)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return apperror.ErrShareNotFound
+	}
+
+	return nil
+}
+
+// SharedGame returns the game a share token unlocks, with abbreviated player names if the share asks
+// for them. No ownership is checked; the token is the only credential.
+func (s *SharesService) SharedGame(ctx context.Context, token string) (entity.Game, error) {
+	share, err := s.repo.FindByToken(ctx, token)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return entity.Game{}, apperror.ErrShareNotFound
+	}
+
+	if err != nil {
+		return entity.Game{}, err
+	}
+
+	sharedGame, err := s.gamesRepo.FindByID(ctx, share.GameID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return entity.Game{}, apperror.ErrShareNotFound
+	}
+
+	if err != nil {
+		return entity.Game{}, err
+	}
+
+	if share.AbbreviateNames {
+		abbreviateNames(&sharedGame)
+	}
+
+	return sharedGame, nil
+}
+
+// SharedStandings returns the live standings of the game a share token unlocks, including the scores
+// entered so far in the round in progress.
+func (s *SharesService) SharedStandings(ctx context.Context, token string) (standings.Standings, error) {
+	sharedGame, err := s.SharedGame(ctx, token)
+	if err != nil {
+		return standings.Standings{}, err
+	}
+
+	return liveStandings(sharedGame), nil
+}
+
+// liveStandings calculates the standings after the last round that has been started, so that unlike
+// the default standings they include the round in progress.
+func liveStandings(sharedGame entity.Game) standings.Standings {
+	lastStarted := 0
+
+	for _, round := range sharedGame.Rounds {
+		if round.Status != entity.RoundStatusSetup {
+			lastStarted = max(lastStarted, round.RoundNumber)
+		}
+	}
+
+	return standings.Calculate(sharedGame, lastStarted)
+}
+
+// abbreviateNames abbreviates the names of all players of a game, including the players seated at tables.
+func abbreviateNames(sharedGame *entity.Game) {
+	players := sharedGame.AllPlayers()
+
+	for _, round := range sharedGame.Rounds {
+		for _, table := range round.Tables {
+			players = append(players, table.Players...)
+		}
+	}
+
+	for _, player := range players {
+		player.Name = Abbreviate(player.Name)
+	}
+}
+
+// Abbreviate shortens a name to its first word followed by the initials of all other words, so
+// "Jürgen Müller" becomes "Jürgen M.". Names of a single word are kept.
+func Abbreviate(name string) string {
+	words := strings.Fields(name)
+	if len(words) < 2 {
+		return name
+	}
+
+	abbreviated := words[0]
+
+	for _, word := range words[1:] {
+		initial, _ := utf8.DecodeRuneInString(word)
+		abbreviated += " " + string(initial) + "."
+	}
+
+	return abbreviated
+}
@@ -0,0 +1,63 @@
+package share
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+func TestAbbreviate(t *testing.T) {
+	tests := map[string]string{
+		"Jürgen Müller":       "Jürgen M.",
+		"Anna Maria von Berg": "Anna M. v. B.",
+		"Käthe":               "Käthe",
+		"  Max   Mustermann ": "Max M.",
+		"":                    "",
+	}
+
+	for name, expected := range tests {
+		assert.Equal(t, expected, Abbreviate(name), name)
+		assert.Equal(t, expected, Abbreviate(Abbreviate(name)), "abbreviating twice changes nothing: %s", name)
+	}
+}
+
+func TestAbbreviateNames(t *testing.T) {
+	seated := &entity.Player{ID: 1, Name: "Jürgen Müller"}
+	copied := &entity.Player{ID: 2, Name: "Erika Mustermann"}
+	sharedGame := entity.Game{
+		Teams:   []*entity.Team{{Name: "Team Müller", Players: []*entity.Player{seated, {ID: 2, Name: "Erika Mustermann"}}}},
+		Players: []*entity.Player{{ID: 3, Name: "Max Mustermann"}},
+		Rounds:  []*entity.Round{{Tables: []*entity.GameTable{{Players: []*entity.Player{seated, copied}}}}},
+	}
+
+	abbreviateNames(&sharedGame)
+
+	assert.Equal(t, "Jürgen M.", seated.Name)
+	assert.Equal(t, "Erika M.", copied.Name, "players loaded separately for tables are abbreviated too")
+	assert.Equal(t, "Erika M.", sharedGame.Teams[0].Players[1].Name)
+	assert.Equal(t, "Max M.", sharedGame.Players[0].Name)
+	assert.Equal(t, "Team Müller", sharedGame.Teams[0].Name, "team names are kept")
+}
+
+func TestLiveStandings(t *testing.T) {
+	player := &entity.Player{ID: 1, Name: "Player 1"}
+	table := func(score int) []*entity.GameTable {
+		return []*entity.GameTable{{Players: []*entity.Player{player}, Scores: []*entity.Score{{PlayerID: 1, Score: score}}}}
+	}
+	sharedGame := entity.Game{
+		Mode:    entity.GameModeIndividual,
+		Players: []*entity.Player{player},
+		Rounds: []*entity.Round{
+			{RoundNumber: 1, Status: entity.RoundStatusCompleted, Tables: table(3)},
+			{RoundNumber: 2, Status: entity.RoundStatusInProgress, Tables: table(4)},
+			{RoundNumber: 3, Status: entity.RoundStatusSetup, Tables: table(0)},
+		},
+	}
+
+	got := liveStandings(sharedGame)
+
+	assert.Equal(t, 2, got.AfterRound, "the round in progress is included, the rounds not started are not")
+	assert.Equal(t, 7, got.Players[0].TotalScore)
+}
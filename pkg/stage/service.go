@@ -0,0 +1,247 @@
+package stage
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/henok321/knobel-manager-service/gen/api"
+	"github.com/henok321/knobel-manager-service/pkg/apperror"
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+	"github.com/henok321/knobel-manager-service/pkg/game"
+	"github.com/henok321/knobel-manager-service/pkg/setup"
+	"github.com/henok321/knobel-manager-service/pkg/standings"
+)
+
+type StagesService struct {
+	gamesService *game.GamesService
+}
+
+func NewStagesService(gamesService *game.GamesService) *StagesService {
+	return &StagesService{gamesService: gamesService}
+}
+
+// Stages returns the stages of a game in the order they are played.
+func (s *StagesService) Stages(ctx context.Context, gameID int, sub string) ([]*entity.Stage, error) {
+	gameByID, err := s.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return nil, err
+	}
+
+	return gameByID.Stages, nil
+}
+
+// ConfigureStages replaces the stages of a game as long as no round has been drawn. The first stage
+// is open to all players; every later stage qualifies the best players of the stage before, either
+// overall or per group.
+func (s *StagesService) ConfigureStages(ctx context.Context, gameID int, sub string, request api.StagesRequest) ([]*entity.Stage, error) {
+	gameByID, err := s.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(gameByID.Rounds) > 0 {
+		return nil, apperror.ErrStagesLocked
+	}
+
+	stages := make([]entity.Stage, len(request.Stages))
+
+	for i, stageRequest := range request.Stages {
+		stage := entity.Stage{
+			Name:           stageRequest.Name,
+			PairingMode:    entity.PairingRandom,
+			NumberOfRounds: stageRequest.NumberOfRounds,
+			Groups:         1,
+			Qualification:  entity.QualificationRule(stageRequest.Qualification),
+			CarryOver:      entity.CarryOverNone,
+		}
+
+		if stageRequest.PairingMode != nil {
+			stage.PairingMode = entity.PairingMode(*stageRequest.PairingMode)
+		}
+
+		if stageRequest.Groups != nil {
+			stage.Groups = *stageRequest.Groups
+		}
+
+		if stageRequest.Qualifiers != nil {
+			stage.Qualifiers = *stageRequest.Qualifiers
+		}
+
+		if stageRequest.CarryOver != nil {
+			stage.CarryOver = entity.CarryOver(*stageRequest.CarryOver)
+		}
+
+		if !validStage(stage, i == 0) {
+			return nil, apperror.ErrInvalidStages
+		}
+
+		stages[i] = stage
+	}
+
+	saved, err := s.gamesService.ReplaceStages(ctx, gameID, stages)
+	if err != nil {
+		return nil, err
+	}
+
+	configured := make([]*entity.Stage, len(saved))
+	for i := range saved {
+		configured[i] = &saved[i]
+	}
+
+	return configured, nil
+}
+
+// validStage checks the settings of a stage. Only the first stage is open to all players, and the
+// stages after it need at least two qualifiers overall or one per group.
+func validStage(stage entity.Stage, first bool) bool {
+	if stage.Name == "" || stage.NumberOfRounds < 1 || stage.Groups < 1 {
+		return false
+	}
+
+	switch stage.Qualification {
+	case entity.QualifyAll:
+		return first
+	case entity.QualifyTopOverall:
+		return !first && stage.Qualifiers >= 2
+	case entity.QualifyTopPerGroup:
+		return !first && stage.Qualifiers >= 1
+	default:
+		return false
+	}
+}
+
+// Advance completes the stage in progress once all its rounds are drawn and completed and starts the
+// next stage: the players qualifying for it are dealt over its groups in snake order by their place in
+// the previous stage and its rounds are drawn from the given seed, or a generated one. The first stage
+// seats all players, whole teams dealt over the groups. Advancing from the last stage completes it.
+func (s *StagesService) Advance(ctx context.Context, gameID int, sub string, seed *int64) (entity.Stage, error) {
+	gameByID, err := s.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return entity.Stage{}, err
+	}
+
+	current := game.CurrentStage(gameByID)
+
+	if current != nil {
+		stageRounds := game.StageRounds(gameByID, current.ID)
+		if len(stageRounds) < current.NumberOfRounds {
+			return entity.Stage{}, apperror.ErrStageIncomplete
+		}
+
+		for _, round := range stageRounds {
+			if round.Status != entity.RoundStatusCompleted {
+				return entity.Stage{}, apperror.ErrStageIncomplete
+			}
+		}
+	}
+
+	nextIndex := slices.IndexFunc(gameByID.Stages, func(stage *entity.Stage) bool {
+		return stage.Status == entity.StageStatusPending
+	})
+
+	if nextIndex < 0 {
+		if current == nil {
+			return entity.Stage{}, apperror.ErrNoNextStage
+		}
+
+		return s.gamesService.CompleteStage(ctx, *current)
+	}
+
+	next := *gameByID.Stages[nextIndex]
+
+	if current == nil {
+		if nextIndex > 0 || len(gameByID.Rounds) > 0 {
+			return entity.Stage{}, apperror.ErrNoNextStage
+		}
+
+		next.Players = openStagePlayers(gameByID, next.Groups)
+	} else {
+		next.Players = qualifiedStagePlayers(gameByID, *current, next)
+	}
+
+	drawSeed := time.Now().Unix()
+	if seed != nil {
+		drawSeed = *seed
+	}
+
+	return s.gamesService.StartStage(ctx, gameByID, next, drawSeed)
+}
+
+// openStagePlayers lists all players of a game but substitutes for a stage open to all players. Teams
+// are dealt over the groups in order of their IDs, and players of an individual game count as teams of
+// their own.
+func openStagePlayers(gameByID entity.Game, groups int) []*entity.StagePlayer {
+	teams := map[int][]int{}
+	for _, player := range gameByID.AllPlayers() {
+		if !player.Substitute {
+			drawPlayer := game.DrawPlayer(player)
+			teams[drawPlayer.TeamID] = append(teams[drawPlayer.TeamID], player.ID)
+		}
+	}
+
+	teamIDs := make([]int, 0, len(teams))
+	for teamID := range teams {
+		teamIDs = append(teamIDs, teamID)
+	}
+
+	slices.Sort(teamIDs)
+
+	var stagePlayers []*entity.StagePlayer
+
+	for group, groupTeams := range setup.SnakeGroups(teamIDs, groups) {
+		for _, teamID := range groupTeams {
+			for _, playerID := range teams[teamID] {
+				stagePlayers = append(stagePlayers, &entity.StagePlayer{PlayerID: playerID, GroupNumber: group + 1})
+			}
+		}
+	}
+
+	return stagePlayers
+}
+
+// qualifiedStagePlayers lists the players of the previous stage qualifying for the next one, ordered by
+// their place in the previous stage and dealt over the groups of the next stage. Players carry their
+// total score of the previous stage over if the next stage says so.
+func qualifiedStagePlayers(gameByID entity.Game, previous, next entity.Stage) []*entity.StagePlayer {
+	groups := make(map[int]int, len(previous.Players))
+	for _, stagePlayer := range previous.Players {
+		groups[stagePlayer.PlayerID] = stagePlayer.GroupNumber
+	}
+
+	ranked := standings.CalculateStage(gameByID, previous, 0).Players
+
+	qualified := map[int]int{}
+	totals := make(map[int]int, len(ranked))
+	qualifierIDs := make([]int, 0, len(ranked))
+
+	for _, player := range ranked {
+		limitKey := 0
+		if next.Qualification == entity.QualifyTopPerGroup {
+			limitKey = groups[player.PlayerID]
+		}
+
+		if qualified[limitKey] >= next.Qualifiers {
+			continue
+		}
+
+		qualified[limitKey]++
+		totals[player.PlayerID] = player.TotalScore
+		qualifierIDs = append(qualifierIDs, player.PlayerID)
+	}
+
+	var stagePlayers []*entity.StagePlayer
+
+	for group, playerIDs := range setup.SnakeGroups(qualifierIDs, next.Groups) {
+		for _, playerID := range playerIDs {
+			stagePlayer := &entity.StagePlayer{PlayerID: playerID, GroupNumber: group + 1}
+			if next.CarryOver == entity.CarryOverFull {
+				stagePlayer.CarriedScore = totals[playerID]
+			}
+
+			stagePlayers = append(stagePlayers, stagePlayer)
+		}
+	}
+
+	return stagePlayers
+}
@@ -0,0 +1,302 @@
+package standings
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+// Calculate ranks the teams and players of a game by the sum of their entered scores, counting
+// only the rounds up to afterRound (up to the last completed round if afterRound is zero). The ranks
+// after the preceding completed round are reported as previous rank and rank change.
+// A seated player without a score marks the player, the team and the standings as incomplete.
+// A player sitting out a round is credited with the bye score of the game for that round.
+// Substitutions are counted for the replaced player and the substitute; scores stay with whoever played.
+// Players of an individual game are ranked individually and belong to no team.
+func Calculate(game entity.Game, afterRound int) Standings {
+	rounds := slices.Clone(game.Rounds)
+	slices.SortFunc(rounds, func(a, b *entity.Round) int {
+		return cmp.Compare(a.RoundNumber, b.RoundNumber)
+	})
+
+	if afterRound == 0 {
+		rounds = rounds[:lastCompleted(rounds)+1]
+	} else {
+		rounds = slices.DeleteFunc(rounds, func(round *entity.Round) bool {
+			return round.RoundNumber > afterRound
+		})
+	}
+
+	current := calculate(game, rounds, nil)
+
+	if len(rounds) > 1 {
+		if previous := lastCompleted(rounds[:len(rounds)-1]); previous >= 0 {
+			applyMovement(&current, calculate(game, rounds[:previous+1], nil))
+		}
+	}
+
+	return current
+}
+
+// lastCompleted returns the index of the last completed round of rounds sorted by round number, -1 if
+// no round is completed.
+func lastCompleted(rounds []*entity.Round) int {
+	for i, round := range slices.Backward(rounds) {
+		if round.Status == entity.RoundStatusCompleted {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// calculate ranks the teams and players of a game over the given rounds. Every player starts with the
+// score carried over to them, if any.
+func calculate(game entity.Game, rounds []*entity.Round, carried map[int]int) Standings {
+	players := map[int]*PlayerStanding{}
+	teams := make([]*TeamStanding, 0, len(game.Teams))
+
+	for _, team := range game.Teams {
+		teamStanding := &TeamStanding{TeamID: team.ID, Name: team.Name, Rounds: emptyRounds(rounds), Complete: true}
+		teams = append(teams, teamStanding)
+
+		for _, player := range team.Players {
+			players[player.ID] = &PlayerStanding{PlayerID: player.ID, Name: player.Name, TeamID: team.ID, TotalScore: carried[player.ID], Rounds: emptyRounds(rounds), Complete: true}
+		}
+	}
+
+	for _, player := range game.Players {
+		players[player.ID] = &PlayerStanding{PlayerID: player.ID, Name: player.Name, TotalScore: carried[player.ID], Rounds: emptyRounds(rounds), Complete: true}
+	}
+
+	teamRanker := newRanker(game, "team")
+	playerRanker := newRanker(game, "player")
+
+	for i, round := range rounds {
+		for _, bye := range round.Byes {
+			playerStanding, ok := players[bye.PlayerID]
+			if !ok {
+				continue
+			}
+
+			playerStanding.TotalScore += game.ByeScore
+			playerStanding.Rounds[i].Score += game.ByeScore
+			playerStanding.Byes++
+		}
+
+		for _, substitution := range round.Substitutions {
+			if playerStanding, ok := players[substitution.PlayerID]; ok {
+				playerStanding.SubstitutedOut++
+			}
+
+			if playerStanding, ok := players[substitution.SubstituteID]; ok {
+				playerStanding.SubstitutedIn++
+			}
+		}
+
+		for _, table := range round.Tables {
+			shortTable := len(table.Players) < table.Capacity
+
+			scores := make(map[int]int, len(table.Scores))
+			for _, score := range table.Scores {
+				scores[score.PlayerID] = score.Score
+			}
+
+			var results []tableResult
+
+			for _, player := range table.Players {
+				playerStanding, ok := players[player.ID]
+				if !ok {
+					continue
+				}
+
+				if shortTable {
+					playerStanding.ShortTables++
+				}
+
+				score, scored := scores[player.ID]
+				if !scored {
+					playerStanding.Complete = false
+					continue
+				}
+
+				playerStanding.TotalScore += score
+				playerStanding.Rounds[i].Score += score
+
+				results = append(results, tableResult{playerID: player.ID, teamID: playerStanding.TeamID, score: score})
+			}
+
+			recordTable(results, playerRanker, teamRanker)
+		}
+	}
+
+	teamsByID := make(map[int]*TeamStanding, len(teams))
+	for _, teamStanding := range teams {
+		teamsByID[teamStanding.TeamID] = teamStanding
+	}
+
+	complete := true
+
+	for _, playerStanding := range players {
+		if !playerStanding.Complete {
+			complete = false
+		}
+
+		teamStanding, ok := teamsByID[playerStanding.TeamID]
+		if !ok {
+			continue
+		}
+
+		teamStanding.TotalScore += playerStanding.TotalScore
+		teamStanding.Byes += playerStanding.Byes
+		teamStanding.ShortTables += playerStanding.ShortTables
+
+		for i, subtotal := range playerStanding.Rounds {
+			teamStanding.Rounds[i].Score += subtotal.Score
+		}
+
+		if !playerStanding.Complete {
+			teamStanding.Complete = false
+		}
+	}
+
+	playerList := make([]*PlayerStanding, 0, len(players))
+	for _, playerStanding := range players {
+		playerList = append(playerList, playerStanding)
+	}
+
+	afterRound := 0
+	if len(rounds) > 0 {
+		afterRound = rounds[len(rounds)-1].RoundNumber
+	}
+
+	return Standings{
+		GameID:     game.ID,
+		AfterRound: afterRound,
+		Complete:   complete,
+		Teams:      rankTeams(teams, teamRanker),
+		Players:    rankPlayers(playerList, playerRanker),
+	}
+}
+
+type tableResult struct {
+	playerID int
+	teamID   int
+	score    int
+}
+
+// recordTable collects the table wins and head-to-head scores of the scored players at one table.
+// Every player with the best score at the table counts a win.
+func recordTable(results []tableResult, players, teams ranker) {
+	if len(results) == 0 {
+		return
+	}
+
+	best := results[0].score
+	teamScores := map[int]int{}
+
+	for _, result := range results {
+		if players.better(result.score, best) > 0 {
+			best = result.score
+		}
+
+		teamScores[result.teamID] += result.score
+	}
+
+	for _, result := range results {
+		if result.score == best {
+			players.tableWins[result.playerID]++
+			teams.tableWins[result.teamID]++
+		}
+
+		for _, opponent := range results {
+			if opponent.playerID != result.playerID {
+				players.headToHead[[2]int{result.playerID, opponent.playerID}] += result.score
+			}
+		}
+	}
+
+	for teamID, score := range teamScores {
+		for opponentID := range teamScores {
+			if opponentID != teamID {
+				teams.headToHead[[2]int{teamID, opponentID}] += score
+			}
+		}
+	}
+}
+
+// applyMovement sets the previous rank and the places gained (positive) or lost (negative)
+// of every entry compared to the previous standings.
+func applyMovement(current *Standings, previous Standings) {
+	previousTeamRanks := make(map[int]int, len(previous.Teams))
+	for _, team := range previous.Teams {
+		previousTeamRanks[team.TeamID] = team.Rank
+	}
+
+	for i, team := range current.Teams {
+		current.Teams[i].PreviousRank = previousTeamRanks[team.TeamID]
+		current.Teams[i].RankChange = previousTeamRanks[team.TeamID] - team.Rank
+	}
+
+	previousPlayerRanks := make(map[int]int, len(previous.Players))
+	for _, player := range previous.Players {
+		previousPlayerRanks[player.PlayerID] = player.Rank
+	}
+
+	for i, player := range current.Players {
+		current.Players[i].PreviousRank = previousPlayerRanks[player.PlayerID]
+		current.Players[i].RankChange = previousPlayerRanks[player.PlayerID] - player.Rank
+	}
+}
+
+func emptyRounds(rounds []*entity.Round) []RoundSubtotal {
+	subtotals := make([]RoundSubtotal, len(rounds))
+	for i, round := range rounds {
+		subtotals[i] = RoundSubtotal{RoundNumber: round.RoundNumber}
+	}
+
+	return subtotals
+}
+
+func rankTeams(teams []*TeamStanding, r ranker) []TeamStanding {
+	entries := make([]entry, len(teams))
+	teamsByID := make(map[int]*TeamStanding, len(teams))
+
+	for i, team := range teams {
+		entries[i] = entry{id: team.TeamID, total: team.TotalScore, rounds: team.Rounds}
+		teamsByID[team.TeamID] = team
+	}
+
+	ranked := make([]TeamStanding, 0, len(teams))
+
+	for _, p := range r.rank(entries) {
+		team := teamsByID[p.id]
+		team.Rank = p.rank
+		team.GapToLeader = p.gapToLeader
+		ranked = append(ranked, *team)
+	}
+
+	return ranked
+}
+
+func rankPlayers(players []*PlayerStanding, r ranker) []PlayerStanding {
+	entries := make([]entry, len(players))
+	playersByID := make(map[int]*PlayerStanding, len(players))
+
+	for i, player := range players {
+		entries[i] = entry{id: player.PlayerID, total: player.TotalScore, rounds: player.Rounds}
+		playersByID[player.PlayerID] = player
+	}
+
+	ranked := make([]PlayerStanding, 0, len(players))
+
+	for _, p := range r.rank(entries) {
+		player := playersByID[p.id]
+		player.Rank = p.rank
+		player.GapToLeader = p.gapToLeader
+		ranked = append(ranked, *player)
+	}
+
+	return ranked
+}
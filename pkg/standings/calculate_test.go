@@ -0,0 +1,224 @@
+package standings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+func scoredTable(players []int, scores map[int]int) *entity.GameTable {
+	table := &entity.GameTable{Capacity: len(players)}
+
+	for _, playerID := range players {
+		table.Players = append(table.Players, &entity.Player{ID: playerID})
+
+		if score, ok := scores[playerID]; ok {
+			table.Scores = append(table.Scores, &entity.Score{PlayerID: playerID, Score: score})
+		}
+	}
+
+	return table
+}
+
+func twoTeamGame() entity.Game {
+	return entity.Game{
+		ID: 1,
+		Teams: []*entity.Team{
+			{ID: 1, Name: "Team 1", Players: []*entity.Player{{ID: 1, Name: "Player 1"}, {ID: 2, Name: "Player 2"}}},
+			{ID: 2, Name: "Team 2", Players: []*entity.Player{{ID: 3, Name: "Player 3"}, {ID: 4, Name: "Player 4"}}},
+		},
+		Rounds: []*entity.Round{
+			{RoundNumber: 2, Status: entity.RoundStatusInProgress, Tables: []*entity.GameTable{
+				scoredTable([]int{1, 4}, map[int]int{1: 4, 4: 1}),
+				scoredTable([]int{2, 3}, map[int]int{2: 6}),
+			}},
+			{RoundNumber: 1, Status: entity.RoundStatusCompleted, Tables: []*entity.GameTable{
+				scoredTable([]int{1, 3}, map[int]int{1: 3, 3: 5}),
+				scoredTable([]int{2, 4}, map[int]int{2: 2, 4: 2}),
+			}},
+		},
+	}
+}
+
+func TestCalculate(t *testing.T) {
+	got := Calculate(twoTeamGame(), 2)
+
+	assert.False(t, got.Complete, "player 3 misses a score in round 2")
+	assert.Equal(t, 2, got.AfterRound)
+
+	assert.Equal(t, []TeamStanding{
+		{Rank: 1, TeamID: 1, Name: "Team 1", TotalScore: 15, GapToLeader: 0, Rounds: []RoundSubtotal{{1, 5}, {2, 10}}, Complete: true, PreviousRank: 2, RankChange: 1},
+		{Rank: 2, TeamID: 2, Name: "Team 2", TotalScore: 8, GapToLeader: 7, Rounds: []RoundSubtotal{{1, 7}, {2, 1}}, Complete: false, PreviousRank: 1, RankChange: -1},
+	}, got.Teams)
+
+	assert.Equal(t, []PlayerStanding{
+		{Rank: 1, PlayerID: 2, Name: "Player 2", TeamID: 1, TotalScore: 8, GapToLeader: 0, Rounds: []RoundSubtotal{{1, 2}, {2, 6}}, Complete: true, PreviousRank: 3, RankChange: 2},
+		{Rank: 2, PlayerID: 1, Name: "Player 1", TeamID: 1, TotalScore: 7, GapToLeader: 1, Rounds: []RoundSubtotal{{1, 3}, {2, 4}}, Complete: true, PreviousRank: 2, RankChange: 0},
+		{Rank: 3, PlayerID: 3, Name: "Player 3", TeamID: 2, TotalScore: 5, GapToLeader: 3, Rounds: []RoundSubtotal{{1, 5}, {2, 0}}, Complete: false, PreviousRank: 1, RankChange: -2},
+		{Rank: 4, PlayerID: 4, Name: "Player 4", TeamID: 2, TotalScore: 3, GapToLeader: 5, Rounds: []RoundSubtotal{{1, 2}, {2, 1}}, Complete: true, PreviousRank: 3, RankChange: -1},
+	}, got.Players)
+}
+
+func TestCalculateAfterRound(t *testing.T) {
+	got := Calculate(twoTeamGame(), 1)
+
+	assert.True(t, got.Complete, "round 2 is not counted")
+	assert.Equal(t, 1, got.AfterRound)
+
+	assert.Equal(t, []TeamStanding{
+		{Rank: 1, TeamID: 2, Name: "Team 2", TotalScore: 7, GapToLeader: 0, Rounds: []RoundSubtotal{{1, 7}}, Complete: true},
+		{Rank: 2, TeamID: 1, Name: "Team 1", TotalScore: 5, GapToLeader: 2, Rounds: []RoundSubtotal{{1, 5}}, Complete: true},
+	}, got.Teams, "the first round has no previous rank")
+
+	assert.Equal(t, []PlayerStanding{
+		{Rank: 1, PlayerID: 3, Name: "Player 3", TeamID: 2, TotalScore: 5, GapToLeader: 0, Rounds: []RoundSubtotal{{1, 5}}, Complete: true},
+		{Rank: 2, PlayerID: 1, Name: "Player 1", TeamID: 1, TotalScore: 3, GapToLeader: 2, Rounds: []RoundSubtotal{{1, 3}}, Complete: true},
+		{Rank: 3, PlayerID: 2, Name: "Player 2", TeamID: 1, TotalScore: 2, GapToLeader: 3, Rounds: []RoundSubtotal{{1, 2}}, Complete: true},
+		{Rank: 3, PlayerID: 4, Name: "Player 4", TeamID: 2, TotalScore: 2, GapToLeader: 3, Rounds: []RoundSubtotal{{1, 2}}, Complete: true},
+	}, got.Players)
+}
+
+func TestCalculateSharedRank(t *testing.T) {
+	game := entity.Game{
+		ID: 1,
+		Teams: []*entity.Team{
+			{ID: 1, Name: "Team 1", Players: []*entity.Player{{ID: 1, Name: "Player 1"}}},
+			{ID: 2, Name: "Team 2", Players: []*entity.Player{{ID: 2, Name: "Player 2"}}},
+			{ID: 3, Name: "Team 3", Players: []*entity.Player{{ID: 3, Name: "Player 3"}}},
+		},
+		Rounds: []*entity.Round{
+			{RoundNumber: 1, Status: entity.RoundStatusCompleted, Tables: []*entity.GameTable{
+				scoredTable([]int{1, 2, 3}, map[int]int{1: 4, 2: 4, 3: 1}),
+			}},
+		},
+	}
+
+	got := Calculate(game, 0)
+
+	assert.True(t, got.Complete)
+
+	ranks := make([]int, len(got.Players))
+	for i, player := range got.Players {
+		ranks[i] = player.Rank
+	}
+
+	assert.Equal(t, []int{1, 1, 3}, ranks, "tied players share a rank and the next rank is skipped")
+}
+
+func TestCalculateWithoutRounds(t *testing.T) {
+	game := entity.Game{
+		ID:    1,
+		Teams: []*entity.Team{{ID: 1, Name: "Team 1", Players: []*entity.Player{{ID: 1, Name: "Player 1"}}}},
+	}
+
+	got := Calculate(game, 0)
+
+	assert.True(t, got.Complete)
+	assert.Equal(t, []TeamStanding{{Rank: 1, TeamID: 1, Name: "Team 1", Rounds: []RoundSubtotal{}, Complete: true}}, got.Teams)
+}
+
+func TestCalculateByesAndShortTables(t *testing.T) {
+	shortTable := scoredTable([]int{2}, map[int]int{2: 1})
+	shortTable.Capacity = 2
+
+	game := entity.Game{
+		ID:        1,
+		TableSize: 2,
+		ByeScore:  3,
+		Teams: []*entity.Team{
+			{ID: 1, Name: "Team 1", Players: []*entity.Player{{ID: 1, Name: "Player 1"}, {ID: 2, Name: "Player 2"}}},
+			{ID: 2, Name: "Team 2", Players: []*entity.Player{{ID: 3, Name: "Player 3"}}},
+		},
+		Rounds: []*entity.Round{
+			{
+				RoundNumber: 1,
+				Status:      entity.RoundStatusCompleted,
+				Tables:      []*entity.GameTable{scoredTable([]int{1, 3}, map[int]int{1: 5, 3: 4}), shortTable},
+			},
+			{
+				RoundNumber: 2,
+				Status:      entity.RoundStatusCompleted,
+				Tables:      []*entity.GameTable{scoredTable([]int{2, 3}, map[int]int{2: 2, 3: 6})},
+				Byes:        []*entity.RoundBye{{PlayerID: 1}},
+			},
+		},
+	}
+
+	got := Calculate(game, 0)
+
+	assert.True(t, got.Complete, "a bye counts as a played round")
+
+	assert.Equal(t, []PlayerStanding{
+		{Rank: 1, PlayerID: 3, Name: "Player 3", TeamID: 2, TotalScore: 10, GapToLeader: 0, Rounds: []RoundSubtotal{{1, 4}, {2, 6}}, Complete: true, PreviousRank: 2, RankChange: 1},
+		{Rank: 2, PlayerID: 1, Name: "Player 1", TeamID: 1, TotalScore: 8, GapToLeader: 2, Rounds: []RoundSubtotal{{1, 5}, {2, 3}}, Complete: true, PreviousRank: 1, RankChange: -1, Byes: 1},
+		{Rank: 3, PlayerID: 2, Name: "Player 2", TeamID: 1, TotalScore: 3, GapToLeader: 7, Rounds: []RoundSubtotal{{1, 1}, {2, 2}}, Complete: true, PreviousRank: 3, RankChange: 0, ShortTables: 1},
+	}, got.Players)
+
+	assert.Equal(t, 1, got.Teams[0].Byes)
+	assert.Equal(t, 1, got.Teams[0].ShortTables)
+}
+
+func TestCalculateSubstitutions(t *testing.T) {
+	game := twoTeamGame()
+	game.Teams[0].Players = append(game.Teams[0].Players, &entity.Player{ID: 5, Name: "Player 5", Substitute: true})
+	game.Rounds[0].Tables[0] = scoredTable([]int{5, 4}, map[int]int{5: 4, 4: 1})
+	game.Rounds[0].Substitutions = []*entity.Substitution{{PlayerID: 1, SubstituteID: 5}}
+
+	got := Calculate(game, 2)
+
+	players := make(map[int]PlayerStanding, len(got.Players))
+	for _, player := range got.Players {
+		players[player.PlayerID] = player
+	}
+
+	assert.Equal(t, 1, players[1].SubstitutedOut)
+	assert.Equal(t, 3, players[1].TotalScore, "the replaced player keeps only the scores played")
+	assert.Equal(t, 1, players[5].SubstitutedIn)
+	assert.Equal(t, 4, players[5].TotalScore)
+	assert.Equal(t, 15, got.Teams[0].TotalScore, "the substitute scores for the team")
+}
+
+func TestCalculateIndividualGame(t *testing.T) {
+	game := entity.Game{
+		ID:      1,
+		Mode:    entity.GameModeIndividual,
+		Players: []*entity.Player{{ID: 1, Name: "Player 1"}, {ID: 2, Name: "Player 2"}, {ID: 3, Name: "Player 3"}},
+		Rounds: []*entity.Round{
+			{RoundNumber: 1, Status: entity.RoundStatusCompleted, Tables: []*entity.GameTable{scoredTable([]int{1, 2, 3}, map[int]int{1: 2, 2: 5, 3: 1})}},
+		},
+	}
+
+	got := Calculate(game, 0)
+
+	assert.True(t, got.Complete)
+	assert.Empty(t, got.Teams)
+	assert.Equal(t, []PlayerStanding{
+		{Rank: 1, PlayerID: 2, Name: "Player 2", TotalScore: 5, GapToLeader: 0, Rounds: []RoundSubtotal{{1, 5}}, Complete: true},
+		{Rank: 2, PlayerID: 1, Name: "Player 1", TotalScore: 2, GapToLeader: 3, Rounds: []RoundSubtotal{{1, 2}}, Complete: true},
+		{Rank: 3, PlayerID: 3, Name: "Player 3", TotalScore: 1, GapToLeader: 4, Rounds: []RoundSubtotal{{1, 1}}, Complete: true},
+	}, got.Players)
+}
+
+func TestCalculateDefaultsToLastCompletedRound(t *testing.T) {
+	got := Calculate(twoTeamGame(), 0)
+
+	assert.Equal(t, Calculate(twoTeamGame(), 1), got, "round 2 is still in progress")
+	assert.Equal(t, 1, got.AfterRound)
+
+	for _, player := range got.Players {
+		assert.Zero(t, player.PreviousRank, "the first completed round has no previous rank")
+	}
+}
+
+func TestCalculateMovementAgainstCompletedRound(t *testing.T) {
+	game := twoTeamGame()
+	game.Rounds[1].Status = entity.RoundStatusInProgress
+
+	got := Calculate(game, 2)
+
+	for _, player := range got.Players {
+		assert.Zero(t, player.PreviousRank, "no rank movement without a previous completed round")
+	}
+}
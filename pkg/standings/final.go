@@ -0,0 +1,84 @@
+package standings
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+// RankFinal ranks the players of a game with a finals stage. Finalists take the first places, ordered
+// by their final score across all final tables according to the score direction of the game, and
+// finalists with equal final scores by their seed. Finalists not scored yet follow the scored ones in
+// seed order. All other players keep their order of the preliminary standings behind the finalists.
+func RankFinal(game entity.Game) FinalRanking {
+	preliminary := Calculate(game, 0)
+	ranking := FinalRanking{GameID: game.ID, Complete: game.Final != nil}
+
+	finalists := map[int]*entity.FinalPlayer{}
+
+	if game.Final != nil {
+		for _, table := range game.Final.Tables {
+			for _, player := range table.Players {
+				finalists[player.PlayerID] = player
+
+				if player.Score == nil {
+					ranking.Complete = false
+				}
+			}
+		}
+	}
+
+	scoreRanker := newRanker(game, "final")
+
+	var finalStandings, otherStandings []FinalStanding
+
+	for _, player := range preliminary.Players {
+		standing := FinalStanding{
+			PlayerID:         player.PlayerID,
+			Name:             player.Name,
+			TeamID:           player.TeamID,
+			PreliminaryRank:  player.Rank,
+			PreliminaryScore: player.TotalScore,
+		}
+
+		finalist, ok := finalists[player.PlayerID]
+		if !ok {
+			otherStandings = append(otherStandings, standing)
+			continue
+		}
+
+		standing.Finalist = true
+		standing.Seed = finalist.Seed
+		standing.FinalScore = finalist.Score
+		finalStandings = append(finalStandings, standing)
+	}
+
+	slices.SortFunc(finalStandings, func(a, b FinalStanding) int {
+		switch {
+		case a.FinalScore == nil && b.FinalScore == nil:
+		case a.FinalScore == nil:
+			return 1
+		case b.FinalScore == nil:
+			return -1
+		default:
+			if byScore := scoreRanker.better(*b.FinalScore, *a.FinalScore); byScore != 0 {
+				return byScore
+			}
+		}
+
+		return cmp.Compare(a.Seed, b.Seed)
+	})
+
+	for i := range finalStandings {
+		finalStandings[i].Rank = i + 1
+	}
+
+	for i, standing := range otherStandings {
+		otherStandings[i].Rank = max(standing.PreliminaryRank, len(finalStandings)+1)
+	}
+
+	ranking.Players = append(finalStandings, otherStandings...)
+
+	return ranking
+}
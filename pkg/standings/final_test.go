@@ -0,0 +1,72 @@
+package standings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+func finalGame(scores map[int]int) entity.Game {
+	game := twoTeamGame()
+	table := &entity.FinalTable{TableNumber: 1}
+
+	for _, round := range game.Rounds {
+		round.Status = entity.RoundStatusCompleted
+	}
+
+	for seed, playerID := range []int{2, 1, 3} {
+		player := &entity.FinalPlayer{PlayerID: playerID, Seed: seed + 1}
+		if score, ok := scores[playerID]; ok {
+			player.Score = &score
+		}
+
+		table.Players = append(table.Players, player)
+	}
+
+	game.Final = &entity.Final{Finalists: 3, Tables: []*entity.FinalTable{table}}
+
+	return game
+}
+
+func TestRankFinal(t *testing.T) {
+	got := RankFinal(finalGame(map[int]int{2: 3, 1: 5, 3: 5}))
+
+	assert.True(t, got.Complete)
+	assert.Equal(t, []FinalStanding{
+		{Rank: 1, PlayerID: 1, Name: "Player 1", TeamID: 1, Finalist: true, Seed: 2, FinalScore: ptr(5), PreliminaryRank: 2, PreliminaryScore: 7},
+		{Rank: 2, PlayerID: 3, Name: "Player 3", TeamID: 2, Finalist: true, Seed: 3, FinalScore: ptr(5), PreliminaryRank: 3, PreliminaryScore: 5},
+		{Rank: 3, PlayerID: 2, Name: "Player 2", TeamID: 1, Finalist: true, Seed: 1, FinalScore: ptr(3), PreliminaryRank: 1, PreliminaryScore: 8},
+		{Rank: 4, PlayerID: 4, Name: "Player 4", TeamID: 2, PreliminaryRank: 4, PreliminaryScore: 3},
+	}, got.Players)
+}
+
+func TestRankFinalLowerWins(t *testing.T) {
+	game := finalGame(map[int]int{2: 3, 1: 5, 3: 4})
+	game.ScoreDirection = entity.LowerWins
+
+	got := RankFinal(game)
+
+	assert.Equal(t, []int{2, 3, 1, 4}, finalOrder(got))
+}
+
+func TestRankFinalIncomplete(t *testing.T) {
+	got := RankFinal(finalGame(map[int]int{2: 3, 1: 5}))
+
+	assert.False(t, got.Complete, "player 3 misses a final score")
+	assert.Equal(t, []int{1, 2, 3, 4}, finalOrder(got))
+}
+
+func finalOrder(ranking FinalRanking) []int {
+	order := make([]int, len(ranking.Players))
+	for i, player := range ranking.Players {
+		order[i] = player.PlayerID
+	}
+
+	return order
+}
+
+func ptr(value int) *int {
+	return &value
+}
@@ -0,0 +1,73 @@
+package standings
+
+type RoundSubtotal struct {
+	RoundNumber int
+	Score       int
+}
+
+type TeamStanding struct {
+	Rank        int
+	TeamID      int
+	Name        string
+	TotalScore  int
+	GapToLeader int
+	Rounds      []RoundSubtotal
+	Complete    bool
+	// PreviousRank is the rank after the preceding round, zero if there is none.
+	PreviousRank int
+	RankChange   int
+	// Byes counts the rounds sat out, ShortTables the rounds played at a table with fewer seats than the table size.
+	Byes        int
+	ShortTables int
+}
+
+type PlayerStanding struct {
+	Rank     int
+	PlayerID int
+	Name     string
+	// TeamID is zero for players of an individual game.
+	TeamID      int
+	TotalScore  int
+	GapToLeader int
+	Rounds      []RoundSubtotal
+	Complete    bool
+	// PreviousRank is the rank after the preceding round, zero if there is none.
+	PreviousRank int
+	RankChange   int
+	// Byes counts the rounds sat out, ShortTables the rounds played at a table with fewer seats than the table size.
+	Byes        int
+	ShortTables int
+	// SubstitutedOut counts the rounds the player was replaced in, SubstitutedIn the rounds played as a substitute.
+	SubstitutedOut int
+	SubstitutedIn  int
+}
+
+type Standings struct {
+	GameID     int
+	AfterRound int
+	Complete   bool
+	Teams      []TeamStanding
+	Players    []PlayerStanding
+}
+
+// FinalStanding is the place of a player in the final ranking of a game with a finals stage.
+type FinalStanding struct {
+	Rank     int
+	PlayerID int
+	Name     string
+	// TeamID is zero for players of an individual game.
+	TeamID   int
+	Finalist bool
+	// Seed is the place the finalist was seeded with, zero for players who did not reach the final.
+	Seed int
+	// FinalScore is nil for players who did not reach the final and for finalists not scored yet.
+	FinalScore       *int
+	PreliminaryRank  int
+	PreliminaryScore int
+}
+
+type FinalRanking struct {
+	GameID   int
+	Complete bool
+	Players  []FinalStanding
+}
@@ -0,0 +1,202 @@
+package standings
+
+import (
+	"cmp"
+	"fmt"
+	"hash/fnv"
+	"slices"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+type entry struct {
+	id     int
+	total  int
+	rounds []RoundSubtotal
+}
+
+type placement struct {
+	id          int
+	rank        int
+	gapToLeader int
+}
+
+// tieKey orders entries of a tied group; a higher key ranks first.
+type tieKey func(e entry, group []entry) int
+
+// ranker orders teams or players by their total score according to the ranking configuration
+// of a game and resolves equal totals with the configured tie-breaker chain.
+type ranker struct {
+	direction   entity.ScoreDirection
+	tieBreakers entity.TieBreakers
+	seed        int64
+	salt        string
+	tableWins   map[int]int
+	headToHead  map[[2]int]int
+}
+
+func newRanker(game entity.Game, salt string) ranker {
+	return ranker{
+		direction:   game.ScoreDirection,
+		tieBreakers: game.TieBreakers,
+		seed:        game.CoinFlipSeed,
+		salt:        salt,
+		tableWins:   map[int]int{},
+		headToHead:  map[[2]int]int{},
+	}
+}
+
+// better returns a positive number if score a beats score b, a negative one if b beats a and zero on a draw.
+func (r ranker) better(a, b int) int {
+	return cmp.Compare(r.oriented(a), r.oriented(b))
+}
+
+func (r ranker) oriented(score int) int {
+	if r.direction == entity.LowerWins {
+		return -score
+	}
+
+	return score
+}
+
+// rank orders the entries and assigns competition ranks. Entries that are still tied after
+// all tie-breakers share a rank and are ordered by ID.
+func (r ranker) rank(entries []entry) []placement {
+	var ordered [][]entry
+
+	for _, group := range splitBy(entries, func(e entry, _ []entry) int { return r.oriented(e.total) }) {
+		ordered = append(ordered, r.breakTies(group, r.tieBreakers)...)
+	}
+
+	placements := make([]placement, 0, len(entries))
+
+	for _, group := range ordered {
+		rank := len(placements) + 1
+
+		for _, e := range group {
+			gap := ordered[0][0].total - e.total
+			if gap < 0 {
+				gap = -gap
+			}
+
+			placements = append(placements, placement{id: e.id, rank: rank, gapToLeader: gap})
+		}
+	}
+
+	return placements
+}
+
+func (r ranker) breakTies(group []entry, tieBreakers entity.TieBreakers) [][]entry {
+	if len(group) < 2 || len(tieBreakers) == 0 {
+		return [][]entry{group}
+	}
+
+	var resolved [][]entry
+
+	for _, subgroup := range splitBy(group, r.key(tieBreakers[0])) {
+		resolved = append(resolved, r.breakTies(subgroup, tieBreakers[1:])...)
+	}
+
+	return resolved
+}
+
+// splitBy orders the entries by descending key, then by ID, and groups entries with equal keys.
+func splitBy(entries []entry, key tieKey) [][]entry {
+	keys := make(map[int]int, len(entries))
+	for _, e := range entries {
+		keys[e.id] = key(e, entries)
+	}
+
+	sorted := slices.Clone(entries)
+	slices.SortFunc(sorted, func(a, b entry) int {
+		return cmp.Or(cmp.Compare(keys[b.id], keys[a.id]), cmp.Compare(a.id, b.id))
+	})
+
+	var groups [][]entry
+
+	for i, e := range sorted {
+		if i > 0 && keys[e.id] == keys[sorted[i-1].id] {
+			groups[len(groups)-1] = append(groups[len(groups)-1], e)
+			continue
+		}
+
+		groups = append(groups, []entry{e})
+	}
+
+	return groups
+}
+
+func (r ranker) key(tieBreaker entity.TieBreaker) tieKey {
+	switch tieBreaker {
+	case entity.TieBreakerBestRound:
+		return r.bestRound
+	case entity.TieBreakerTableWins:
+		return func(e entry, _ []entry) int { return r.tableWins[e.id] }
+	case entity.TieBreakerHeadToHead:
+		return r.headToHeadPoints
+	case entity.TieBreakerFewestZeroRounds:
+		return fewestZeroRounds
+	case entity.TieBreakerCoinFlip:
+		return r.coinFlip
+	default:
+		return func(entry, []entry) int { return 0 }
+	}
+}
+
+func (r ranker) bestRound(e entry, _ []entry) int {
+	best := 0
+
+	for i, round := range e.rounds {
+		if i == 0 || r.oriented(round.Score) > best {
+			best = r.oriented(round.Score)
+		}
+	}
+
+	return best
+}
+
+// headToHeadPoints plays a mini-league among the tied group: for every opponent met at a table,
+// the entry with the better combined score at those tables gets two points, a draw one point each.
+func (r ranker) headToHeadPoints(e entry, group []entry) int {
+	points := 0
+
+	for _, opponent := range group {
+		if opponent.id == e.id {
+			continue
+		}
+
+		own, met := r.headToHead[[2]int{e.id, opponent.id}]
+		if !met {
+			continue
+		}
+
+		switch result := r.better(own, r.headToHead[[2]int{opponent.id, e.id}]); {
+		case result > 0:
+			points += 2
+		case result == 0:
+			points++
+		}
+	}
+
+	return points
+}
+
+func fewestZeroRounds(e entry, _ []entry) int {
+	zeroRounds := 0
+
+	for _, round := range e.rounds {
+		if round.Score == 0 {
+			zeroRounds++
+		}
+	}
+
+	return -zeroRounds
+}
+
+// coinFlip derives a stable random order from the recorded seed of the game.
+func (r ranker) coinFlip(e entry, _ []entry) int {
+	hash := fnv.New64a()
+	_, _ = fmt.Fprintf(hash, "%d/%s/%d", r.seed, r.salt, e.id)
+
+	return int(hash.Sum64() >> 1)
+}
@@ -0,0 +1,129 @@
+package standings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+// tiedGame has players 1 and 2 tied on 5 points behind player 3 with 6 points.
+func tiedGame(direction entity.ScoreDirection, tieBreakers ...entity.TieBreaker) entity.Game {
+	return entity.Game{
+		ID:             1,
+		ScoreDirection: direction,
+		TieBreakers:    tieBreakers,
+		CoinFlipSeed:   42,
+		Teams: []*entity.Team{
+			{ID: 1, Name: "Team 1", Players: []*entity.Player{{ID: 1, Name: "Player 1"}}},
+			{ID: 2, Name: "Team 2", Players: []*entity.Player{{ID: 2, Name: "Player 2"}}},
+			{ID: 3, Name: "Team 3", Players: []*entity.Player{{ID: 3, Name: "Player 3"}}},
+		},
+		Rounds: []*entity.Round{
+			{RoundNumber: 1, Status: entity.RoundStatusCompleted, Tables: []*entity.GameTable{
+				scoredTable([]int{1, 2}, map[int]int{1: 5, 2: 1}),
+				scoredTable([]int{3}, map[int]int{3: 0}),
+			}},
+			{RoundNumber: 2, Status: entity.RoundStatusCompleted, Tables: []*entity.GameTable{
+				scoredTable([]int{1, 3}, map[int]int{1: 0, 3: 6}),
+				scoredTable([]int{2}, map[int]int{2: 4}),
+			}},
+		},
+	}
+}
+
+func TestCalculateRanking(t *testing.T) {
+	tests := map[string]struct {
+		game          entity.Game
+		expectedIDs   []int
+		expectedRanks []int
+		expectedGaps  []int
+	}{
+		"tied without tie-breakers": {
+			game:          tiedGame(entity.HigherWins),
+			expectedIDs:   []int{3, 1, 2},
+			expectedRanks: []int{1, 2, 2},
+			expectedGaps:  []int{0, 1, 1},
+		},
+		"lower score wins": {
+			game:          tiedGame(entity.LowerWins),
+			expectedIDs:   []int{1, 2, 3},
+			expectedRanks: []int{1, 1, 3},
+			expectedGaps:  []int{0, 0, 1},
+		},
+		"best round": {
+			game:          tiedGame(entity.HigherWins, entity.TieBreakerBestRound),
+			expectedIDs:   []int{3, 1, 2},
+			expectedRanks: []int{1, 2, 3},
+			expectedGaps:  []int{0, 1, 1},
+		},
+		"equal table wins fall through to the next tie-breaker": {
+			game:          tiedGame(entity.HigherWins, entity.TieBreakerTableWins, entity.TieBreakerFewestZeroRounds),
+			expectedIDs:   []int{3, 2, 1},
+			expectedRanks: []int{1, 2, 3},
+			expectedGaps:  []int{0, 1, 1},
+		},
+		"head to head": {
+			game:          tiedGame(entity.HigherWins, entity.TieBreakerHeadToHead),
+			expectedIDs:   []int{3, 1, 2},
+			expectedRanks: []int{1, 2, 3},
+			expectedGaps:  []int{0, 1, 1},
+		},
+		"head to head with lower score wins": {
+			game:          tiedGame(entity.LowerWins, entity.TieBreakerHeadToHead),
+			expectedIDs:   []int{2, 1, 3},
+			expectedRanks: []int{1, 2, 3},
+			expectedGaps:  []int{0, 0, 1},
+		},
+		"fewest zero rounds": {
+			game:          tiedGame(entity.HigherWins, entity.TieBreakerFewestZeroRounds),
+			expectedIDs:   []int{3, 2, 1},
+			expectedRanks: []int{1, 2, 3},
+			expectedGaps:  []int{0, 1, 1},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := Calculate(tc.game, 0)
+
+			ids := make([]int, len(got.Players))
+			ranks := make([]int, len(got.Players))
+			gaps := make([]int, len(got.Players))
+
+			for i, player := range got.Players {
+				ids[i] = player.PlayerID
+				ranks[i] = player.Rank
+				gaps[i] = player.GapToLeader
+			}
+
+			assert.Equal(t, tc.expectedIDs, ids)
+			assert.Equal(t, tc.expectedRanks, ranks)
+			assert.Equal(t, tc.expectedGaps, gaps)
+
+			teamIDs := make([]int, len(got.Teams))
+			for i, team := range got.Teams {
+				teamIDs[i] = team.TeamID
+			}
+
+			assert.Equal(t, tc.expectedIDs, teamIDs, "single player teams rank like their players")
+		})
+	}
+}
+
+func TestCalculateCoinFlip(t *testing.T) {
+	game := tiedGame(entity.HigherWins, entity.TieBreakerCoinFlip)
+
+	first := Calculate(game, 0)
+	second := Calculate(game, 0)
+
+	assert.Equal(t, first, second, "the recorded seed yields the same order")
+
+	ranks := make([]int, len(first.Players))
+	for i, player := range first.Players {
+		ranks[i] = player.Rank
+	}
+
+	assert.Equal(t, []int{1, 2, 3}, ranks)
+}
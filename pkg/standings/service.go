@@ -0,0 +1,64 @@
+package standings
+
+import (
+	"context"
+	"slices"
+
+	"github.com/henok321/knobel-manager-service/pkg/apperror"
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+	"github.com/henok321/knobel-manager-service/pkg/game"
+)
+
+type StandingsService struct {
+	gamesService *game.GamesService
+}
+
+func NewStandingsService(gamesService *game.GamesService) *StandingsService {
+	return &StandingsService{gamesService: gamesService}
+}
+
+// Standings returns the standings after the given round, or after the last completed round if afterRound is zero.
+func (s *StandingsService) Standings(ctx context.Context, gameID, afterRound int, sub string) (Standings, error) {
+	gameByID, err := s.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return Standings{}, err
+	}
+
+	if afterRound > 0 && !slices.ContainsFunc(gameByID.Rounds, func(round *entity.Round) bool {
+		return round.RoundNumber == afterRound
+	}) {
+		return Standings{}, apperror.ErrRoundNotFound
+	}
+
+	return Calculate(gameByID, afterRound), nil
+}
+
+// StageStandings returns the standings of the stage at the given position of a game.
+func (s *StandingsService) StageStandings(ctx context.Context, gameID, position int, sub string) (Standings, error) {
+	gameByID, err := s.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return Standings{}, err
+	}
+
+	for _, stage := range gameByID.Stages {
+		if stage.Position == position {
+			return CalculateStage(gameByID, *stage, 0), nil
+		}
+	}
+
+	return Standings{}, apperror.ErrStageNotFound
+}
+
+// FinalRanking returns the final ranking of a game with a finals stage.
+func (s *StandingsService) FinalRanking(ctx context.Context, gameID int, sub string) (FinalRanking, error) {
+	gameByID, err := s.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return FinalRanking{}, err
+	}
+
+	if gameByID.Final == nil {
+		return FinalRanking{}, apperror.ErrFinalOrTableNotFound
+	}
+
+	return RankFinal(gameByID), nil
+}
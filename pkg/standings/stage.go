@@ -0,0 +1,69 @@
+package standings
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+// CalculateStage ranks the players of a stage by their scores in the rounds of the stage up to
+// afterRound, starting from the score carried over from the previous stage. Like Calculate, it stops
+// at the last completed round of the stage if afterRound is zero and reports the ranks after the
+// preceding completed round of the stage as previous rank and rank change. Only the players taking
+// part in the stage are ranked, and teams are ranked by those of their players.
+func CalculateStage(game entity.Game, stage entity.Stage, afterRound int) Standings {
+	carried := make(map[int]int, len(stage.Players))
+	for _, stagePlayer := range stage.Players {
+		carried[stagePlayer.PlayerID] = stagePlayer.CarriedScore
+	}
+
+	stageGame := game
+	stageGame.Teams = nil
+	stageGame.Players = nil
+
+	for _, team := range game.Teams {
+		stageTeam := &entity.Team{ID: team.ID, Name: team.Name, GameID: team.GameID}
+
+		for _, player := range team.Players {
+			if _, ok := carried[player.ID]; ok {
+				stageTeam.Players = append(stageTeam.Players, player)
+			}
+		}
+
+		if len(stageTeam.Players) > 0 {
+			stageGame.Teams = append(stageGame.Teams, stageTeam)
+		}
+	}
+
+	for _, player := range game.Players {
+		if _, ok := carried[player.ID]; ok {
+			stageGame.Players = append(stageGame.Players, player)
+		}
+	}
+
+	rounds := slices.DeleteFunc(slices.Clone(game.Rounds), func(round *entity.Round) bool {
+		return round.StageID == nil || *round.StageID != stage.ID
+	})
+	slices.SortFunc(rounds, func(a, b *entity.Round) int {
+		return cmp.Compare(a.RoundNumber, b.RoundNumber)
+	})
+
+	if afterRound == 0 {
+		rounds = rounds[:lastCompleted(rounds)+1]
+	} else {
+		rounds = slices.DeleteFunc(rounds, func(round *entity.Round) bool {
+			return round.RoundNumber > afterRound
+		})
+	}
+
+	current := calculate(stageGame, rounds, carried)
+
+	if len(rounds) > 1 {
+		if previous := lastCompleted(rounds[:len(rounds)-1]); previous >= 0 {
+			applyMovement(&current, calculate(stageGame, rounds[:previous+1], carried))
+		}
+	}
+
+	return current
+}
@@ -0,0 +1,55 @@
+package standings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+func stagedGame() entity.Game {
+	game := twoTeamGame()
+
+	first, second := 1, 2
+	for _, round := range game.Rounds {
+		round.StageID = &first
+	}
+
+	game.Rounds = append(game.Rounds, &entity.Round{RoundNumber: 3, StageID: &second, Status: entity.RoundStatusCompleted, Tables: []*entity.GameTable{
+		scoredTable([]int{2, 3}, map[int]int{2: 1, 3: 6}),
+	}})
+
+	return game
+}
+
+func TestCalculateStage(t *testing.T) {
+	stage := entity.Stage{ID: 2, Players: []*entity.StagePlayer{
+		{StageID: 2, PlayerID: 2, CarriedScore: 8},
+		{StageID: 2, PlayerID: 3, CarriedScore: 5},
+	}}
+
+	got := CalculateStage(stagedGame(), stage, 0)
+
+	assert.True(t, got.Complete)
+	assert.Equal(t, 3, got.AfterRound)
+
+	assert.Equal(t, []TeamStanding{
+		{Rank: 1, TeamID: 2, Name: "Team 2", TotalScore: 11, GapToLeader: 0, Rounds: []RoundSubtotal{{3, 6}}, Complete: true},
+		{Rank: 2, TeamID: 1, Name: "Team 1", TotalScore: 9, GapToLeader: 2, Rounds: []RoundSubtotal{{3, 1}}, Complete: true},
+	}, got.Teams, "teams are ranked by their players taking part in the stage")
+
+	assert.Equal(t, []PlayerStanding{
+		{Rank: 1, PlayerID: 3, Name: "Player 3", TeamID: 2, TotalScore: 11, GapToLeader: 0, Rounds: []RoundSubtotal{{3, 6}}, Complete: true},
+		{Rank: 2, PlayerID: 2, Name: "Player 2", TeamID: 1, TotalScore: 9, GapToLeader: 2, Rounds: []RoundSubtotal{{3, 1}}, Complete: true},
+	}, got.Players, "carried scores count towards the total but not the round subtotals")
+}
+
+func TestCalculateStageOnlyCountsItsRounds(t *testing.T) {
+	stage := entity.Stage{ID: 1, Players: []*entity.StagePlayer{
+		{StageID: 1, PlayerID: 1}, {StageID: 1, PlayerID: 2}, {StageID: 1, PlayerID: 3}, {StageID: 1, PlayerID: 4},
+	}}
+
+	assert.Equal(t, Calculate(twoTeamGame(), 2), CalculateStage(stagedGame(), stage, 2))
+	assert.Equal(t, Calculate(twoTeamGame(), 0), CalculateStage(stagedGame(), stage, 0), "stage and game standings stop at the last completed round alike")
+}
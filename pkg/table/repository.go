@@ -22,8 +22,10 @@ func (t *TablesRepository) FindTable(ctx context.Context, sub string, gameID, ro
 	err := t.db.WithContext(ctx).
 		Joins("JOIN rounds ON rounds.id = game_tables.round_id").
 		Joins("JOIN game_owners ON game_owners.game_id = rounds.game_id").
+		Preload("Round").
 		Preload("Scores").
 		Preload("Players").
+		Preload("Seats").
 		Where("game_owners.owner_sub = ?", sub).
 		Where("rounds.game_id = ?", gameID).
 		Where("rounds.round_number = ?", roundNumber).
@@ -44,10 +46,53 @@ func (t *TablesRepository) UpdateTable(ctx context.Context, table *entity.GameTa
 		}
 	}
 
-	err := t.db.WithContext(ctx).Preload("Scores").Preload("Players").First(table, table.ID).Error
+	err := t.db.WithContext(ctx).Preload("Scores").Preload("Players").Preload("Seats").First(table, table.ID).Error
 	if err != nil {
 		return entity.GameTable{}, err
 	}
 
 	return *table, nil
 }
+
+// UpdateSeats numbers the seats of a table in the order of the given player IDs.
+func (t *TablesRepository) UpdateSeats(ctx context.Context, tableID int, playerIDs []int) error {
+	for i, playerID := range playerIDs {
+		err := t.db.WithContext(ctx).Model(&entity.TablePlayer{}).
+			Where("game_table_id = ? AND player_id = ?", tableID, playerID).
+			Update("seat", i+1).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *TablesRepository) CreateScoreHistory(ctx context.Context, history []entity.ScoreHistory) error {
+	if len(history) == 0 {
+		return nil
+	}
+
+	return t.db.WithContext(ctx).Create(&history).Error
+}
+
+func (t *TablesRepository) FindScoreHistory(ctx context.Context, gameID, roundNumber, tableNumber int) ([]entity.ScoreHistory, error) {
+	var history []entity.ScoreHistory
+
+	err := t.db.WithContext(ctx).
+		Where("game_id = ? AND round_number = ? AND table_number = ?", gameID, roundNumber, tableNumber).
+		Order("created_at, id").
+		Find(&history).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+func (t *TablesRepository) WithinTransaction(ctx context.Context, operation func(ctx context.Context, txRepo *TablesRepository) error) error {
+	return t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &TablesRepository{db: tx}
+		return operation(ctx, txRepo)
+	})
+}
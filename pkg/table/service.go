@@ -2,6 +2,7 @@ package table
 
 import (
 	"context"
+	"strings"
 
 	"github.com/henok321/knobel-manager-service/gen/api"
 	"github.com/henok321/knobel-manager-service/pkg/apperror"
@@ -22,13 +23,28 @@ func (t *TablesService) UpdateScore(ctx context.Context, gameID, roundNumber, ta
 		return entity.GameTable{}, apperror.ErrRoundOrTableNotFound
 	}
 
-	if len(scoresRequest.Scores) != len(table.Players) {
+	reason := scoresRequest.Reason
+	if reason != nil && strings.TrimSpace(*reason) == "" {
+		reason = nil
+	}
+
+	switch table.Round.Status {
+	case entity.RoundStatusInProgress:
+	case entity.RoundStatusCompleted:
+		if reason == nil {
+			return entity.GameTable{}, apperror.ErrReasonRequired
+		}
+	default:
+		return entity.GameTable{}, apperror.ErrRoundNotInProgress
+	}
+
+	if len(table.Players) > table.Capacity || len(scoresRequest.Scores) != len(table.Players) {
 		return entity.GameTable{}, apperror.ErrInvalidScore
 	}
 
-	seatedPlayers := make(map[int]bool, len(table.Players))
+	seatedPlayers := make(map[int]*entity.Player, len(table.Players))
 	for _, player := range table.Players {
-		seatedPlayers[player.ID] = true
+		seatedPlayers[player.ID] = player
 	}
 
 	existingScores := make(map[int]*entity.Score)
@@ -37,15 +53,35 @@ func (t *TablesService) UpdateScore(ctx context.Context, gameID, roundNumber, ta
 	}
 
 	scores := make([]*entity.Score, 0, len(table.Players))
+	history := make([]entity.ScoreHistory, 0, len(table.Players))
+
 	for _, s := range scoresRequest.Scores {
-		if !seatedPlayers[s.PlayerID] {
+		player, seated := seatedPlayers[s.PlayerID]
+		if !seated {
 			return entity.GameTable{}, apperror.ErrInvalidScore
 		}
 
+		entry := entity.ScoreHistory{
+			TableID:     &table.ID,
+			GameID:      gameID,
+			RoundNumber: roundNumber,
+			TableNumber: tableNumber,
+			PlayerID:    &player.ID,
+			PlayerName:  player.Name,
+			NewScore:    s.Score,
+			OwnerSub:    sub,
+			Reason:      reason,
+		}
+
 		if existingScore, exists := existingScores[s.PlayerID]; exists {
+			oldScore := existingScore.Score
+			entry.OldScore = &oldScore
+			history = append(history, entry)
+
 			existingScore.Score = s.Score
 			scores = append(scores, existingScore)
 		} else {
+			history = append(history, entry)
 			scores = append(scores, &entity.Score{
 				PlayerID: s.PlayerID,
 				TableID:  table.ID,
@@ -56,10 +92,73 @@ func (t *TablesService) UpdateScore(ctx context.Context, gameID, roundNumber, ta
 
 	table.Scores = scores
 
-	table, err = t.repo.UpdateTable(ctx, &table)
+	err = t.repo.WithinTransaction(ctx, func(ctx context.Context, txRepo *TablesRepository) error {
+		if err := txRepo.CreateScoreHistory(ctx, history); err != nil {
+			return err
+		}
+
+		table, err = txRepo.UpdateTable(ctx, &table)
+
+		return err
+	})
+	if err != nil {
+		return entity.GameTable{}, err
+	}
+
+	return table, nil
+}
+
+// UpdateSeatOrder overrides the seat order of a table with the given order of its players. The seat
+// order can be changed until the round is completed.
+func (t *TablesService) UpdateSeatOrder(ctx context.Context, gameID, roundNumber, tableNumber int, sub string, seatOrderRequest api.SeatOrderRequest) (entity.GameTable, error) {
+	table, err := t.repo.FindTable(ctx, sub, gameID, roundNumber, tableNumber)
+	if err != nil {
+		return entity.GameTable{}, apperror.ErrRoundOrTableNotFound
+	}
+
+	if table.Round.Status == entity.RoundStatusCompleted {
+		return entity.GameTable{}, apperror.ErrRoundCompleted
+	}
+
+	seatedPlayers := make(map[int]bool, len(table.Players))
+	for _, player := range table.Players {
+		seatedPlayers[player.ID] = true
+	}
+
+	if len(seatOrderRequest.SeatOrder) != len(seatedPlayers) {
+		return entity.GameTable{}, apperror.ErrInvalidSeatOrder
+	}
+
+	for _, playerID := range seatOrderRequest.SeatOrder {
+		if !seatedPlayers[playerID] {
+			return entity.GameTable{}, apperror.ErrInvalidSeatOrder
+		}
+
+		delete(seatedPlayers, playerID)
+	}
+
+	err = t.repo.WithinTransaction(ctx, func(ctx context.Context, txRepo *TablesRepository) error {
+		if err := txRepo.UpdateSeats(ctx, table.ID, seatOrderRequest.SeatOrder); err != nil {
+			return err
+		}
+
+		table, err = txRepo.UpdateTable(ctx, &table)
+
+		return err
+	})
 	if err != nil {
 		return entity.GameTable{}, err
 	}
 
 	return table, nil
 }
+
+// ScoreHistory lists the recorded score writes of a table in chronological order, including those
+// recorded before the round was redrawn.
+func (t *TablesService) ScoreHistory(ctx context.Context, gameID, roundNumber, tableNumber int, sub string) ([]entity.ScoreHistory, error) {
+	if _, err := t.repo.FindTable(ctx, sub, gameID, roundNumber, tableNumber); err != nil {
+		return nil, apperror.ErrRoundOrTableNotFound
+	}
+
+	return t.repo.FindScoreHistory(ctx, gameID, roundNumber, tableNumber)
+}
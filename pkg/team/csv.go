@@ -0,0 +1,125 @@
+package team
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/henok321/knobel-manager-service/pkg/apperror"
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+// utf8BOM marks UTF-8 text written by spreadsheet applications such as Excel.
+const utf8BOM = "\xef\xbb\xbf"
+
+// LineError is a line of a CSV import that cannot be imported.
+type LineError struct {
+	Line    int
+	Message string
+}
+
+// ImportError lists the lines of a CSV import that cannot be imported. It wraps apperror.ErrInvalidImport.
+type ImportError struct {
+	Lines []LineError
+}
+
+func (e *ImportError) Error() string {
+	return fmt.Sprintf("%d invalid lines", len(e.Lines))
+}
+
+func (e *ImportError) Unwrap() error {
+	return apperror.ErrInvalidImport
+}
+
+// ParseTeams reads teams from UTF-8 encoded CSV with the team name in the first and the player name in
+// the second column, skipping the first line if it is a header. Lines of the same team name make up one
+// team of at most teamSize players; the teams are returned in the order of their first line. All invalid
+// lines are reported, except that reading stops at a line that is not valid CSV.
+func ParseTeams(r io.Reader, delimiter rune, header bool, teamSize int) ([]entity.Team, []LineError) {
+	buffered := bufio.NewReader(r)
+	if bom, err := buffered.Peek(len(utf8BOM)); err == nil && string(bom) == utf8BOM {
+		_, _ = buffered.Discard(len(utf8BOM))
+	}
+
+	reader := csv.NewReader(buffered)
+	reader.Comma = delimiter
+	reader.FieldsPerRecord = -1
+
+	var (
+		teams      []entity.Team
+		lineErrors []LineError
+	)
+
+	teamIndex := map[string]int{}
+
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				lineErrors = append(lineErrors, LineError{Line: parseErr.Line, Message: parseErr.Err.Error()})
+			} else {
+				lineErrors = append(lineErrors, LineError{Message: err.Error()})
+			}
+
+			break
+		}
+
+		if first && header {
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		if message := invalidRecord(record); message != "" {
+			lineErrors = append(lineErrors, LineError{Line: line, Message: message})
+			continue
+		}
+
+		teamName, playerName := strings.TrimSpace(record[0]), strings.TrimSpace(record[1])
+
+		i, ok := teamIndex[teamName]
+		if !ok {
+			i = len(teams)
+			teamIndex[teamName] = i
+			teams = append(teams, entity.Team{Name: teamName})
+		}
+
+		if len(teams[i].Players) == teamSize {
+			lineErrors = append(lineErrors, LineError{Line: line, Message: fmt.Sprintf("team %q has more than %d players", teamName, teamSize)})
+			continue
+		}
+
+		teams[i].Players = append(teams[i].Players, &entity.Player{Name: playerName})
+	}
+
+	return teams, lineErrors
+}
+
+// invalidRecord describes what is wrong with a CSV record, or returns an empty string for a valid one.
+func invalidRecord(record []string) string {
+	for _, field := range record {
+		if !utf8.ValidString(field) {
+			return "line is not valid UTF-8"
+		}
+	}
+
+	switch {
+	case len(record) != 2:
+		return fmt.Sprintf("expected 2 columns, found %d", len(record))
+	case strings.TrimSpace(record[0]) == "":
+		return "team name is missing"
+	case strings.TrimSpace(record[1]) == "":
+		return "player name is missing"
+	default:
+		return ""
+	}
+}
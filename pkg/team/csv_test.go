@@ -0,0 +1,47 @@
+package team
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/henok321/knobel-manager-service/pkg/entity"
+)
+
+func TestParseTeams(t *testing.T) {
+	csv := "\xef\xbb\xbfTeam;Spieler\n" +
+		"Team Müller;Jürgen Müller\n" +
+		"\n" +
+		" Team Schön ; Björn Schön\n" +
+		"\"Team Müller\";\"Käthe; geb. Schmidt\"\n"
+
+	teams, lineErrors := ParseTeams(strings.NewReader(csv), ';', true, 2)
+
+	assert.Empty(t, lineErrors)
+	assert.Equal(t, []entity.Team{
+		{Name: "Team Müller", Players: []*entity.Player{{Name: "Jürgen Müller"}, {Name: "Käthe; geb. Schmidt"}}},
+		{Name: "Team Schön", Players: []*entity.Player{{Name: "Björn Schön"}}},
+	}, teams, "lines of the same team make up one team in the order of their first line")
+}
+
+func TestParseTeamsReportsInvalidLines(t *testing.T) {
+	csv := "Team 1,Player 1\n" +
+		"Team 1,Player 2\n" +
+		"Team 1,Player 3\n" +
+		",Player 4\n" +
+		"Team 2,Player 5,Player 6\n" +
+		"Team 3,\xff\n" +
+		"Team 4,\"Player \"7\"\n" +
+		"Team 5,Player 8\n"
+
+	_, lineErrors := ParseTeams(strings.NewReader(csv), ',', false, 2)
+
+	assert.Equal(t, []LineError{
+		{Line: 3, Message: `team "Team 1" has more than 2 players`},
+		{Line: 4, Message: "team name is missing"},
+		{Line: 5, Message: "expected 2 columns, found 3"},
+		{Line: 6, Message: "line is not valid UTF-8"},
+		{Line: 7, Message: `extraneous or missing " in quoted-field`},
+	}, lineErrors, "reading stops at the first line that is not valid CSV")
+}
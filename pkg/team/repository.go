@@ -36,6 +36,18 @@ func (r *TeamsRepository) CreateOrUpdateTeam(ctx context.Context, team *entity.T
 	return *team, nil
 }
 
+// CreateTeams creates teams with their players in one transaction.
+func (r *TeamsRepository) CreateTeams(ctx context.Context, teams []entity.Team) ([]entity.Team, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.Create(&teams).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
 func (r *TeamsRepository) DeleteTeam(ctx context.Context, id int) error {
 	return r.db.WithContext(ctx).Delete(&entity.Team{}, id).Error
 }
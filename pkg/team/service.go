@@ -2,6 +2,7 @@ package team
 
 import (
 	"context"
+	"io"
 
 	"github.com/henok321/knobel-manager-service/gen/api"
 	"github.com/henok321/knobel-manager-service/pkg/apperror"
@@ -27,6 +28,10 @@ func (s *TeamsService) CreateTeam(ctx context.Context, gameID int, sub string, r
 		return entity.Team{}, err
 	}
 
+	if gameByID.Mode == entity.GameModeIndividual {
+		return entity.Team{}, apperror.ErrGameModeMismatch
+	}
+
 	var playerCount int
 	if request.Players != nil {
 		playerCount = len(*request.Players)
@@ -53,6 +58,34 @@ func (s *TeamsService) CreateTeam(ctx context.Context, gameID int, sub string, r
 	return s.teamRepo.CreateOrUpdateTeam(ctx, &team)
 }
 
+// ImportTeams creates the teams read from CSV by ParseTeams, either all of them or, if any line is
+// invalid, none, failing with an ImportError listing the invalid lines.
+func (s *TeamsService) ImportTeams(ctx context.Context, gameID int, sub string, csv io.Reader, delimiter rune, header bool) ([]entity.Team, error) {
+	gameByID, err := s.gamesService.FindByID(ctx, gameID, sub)
+	if err != nil {
+		return nil, err
+	}
+
+	if gameByID.Mode == entity.GameModeIndividual {
+		return nil, apperror.ErrGameModeMismatch
+	}
+
+	teams, lineErrors := ParseTeams(csv, delimiter, header, gameByID.TeamSize)
+	if len(lineErrors) > 0 {
+		return nil, &ImportError{Lines: lineErrors}
+	}
+
+	if len(teams) == 0 {
+		return nil, apperror.ErrEmptyImport
+	}
+
+	for i := range teams {
+		teams[i].GameID = gameID
+	}
+
+	return s.teamRepo.CreateTeams(ctx, teams)
+}
+
 func (s *TeamsService) UpdateTeam(ctx context.Context, gameID int, sub string, teamID int, request api.TeamsRequest) (entity.Team, error) {
 	gameByID, err := s.gamesService.FindByID(ctx, gameID, sub)
 	if err != nil {
@@ -29,6 +29,7 @@ echo "→ Regenerating code from openapi/openapi.yaml..."
 cd openapi/config
 go tool oapi-codegen -config=health.yaml ../openapi.yaml >/dev/null
 go tool oapi-codegen -config=api.yaml ../openapi.yaml >/dev/null
+go tool oapi-codegen -config=public.yaml ../openapi.yaml >/dev/null
 cd ../..
 
 echo "→ Comparing regenerated code with checked-in gen/..."